@@ -0,0 +1,286 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/elecbug/go-netrics/internal/graph"
+)
+
+// adjacency is an adjacency-list view of a graph, built once at the start of a computation.
+// Rows are indexed by the dense positions 0..n-1 of the graph's nodes, so gaps left by removed nodes never appear.
+// Use id and position to convert between rows and node identifiers.
+// The rows are slices into a graph.Adjacency snapshot and must not be modified.
+// Parallel edges of a multigraph are collapsed into one edge per pair of nodes.
+//
+// Fields:
+//   - index: The mapping between rows and node identifiers.
+//   - out: The targets of the edges leaving each node, in ascending order.
+//   - weight: The distances of the edges in `out`, aligned index by index; the smallest of parallel edges.
+//   - strength: The weights of the edges in `out` for degree, random-walk and spectral measures.
+//   - in: The sources of the edges entering each node, in ascending order.
+//   - inWeight: The distances of the edges in `in`, aligned index by index; the smallest of parallel edges.
+//   - inStrength: The weights of the edges in `in` for degree, random-walk and spectral measures.
+//   - weighted: Whether edge weights must be taken into account.
+//   - negative: Whether any edge has a negative weight, which rules out Dijkstra's algorithm.
+//   - potential: The Johnson potentials the weights were shifted by, or nil for the original weights.
+//   - directed: Whether the edges of the graph have a direction.
+//   - collapse: How parallel edges were combined into `strength` and `inStrength`.
+//   - version: The version of the graph the view was built from.
+type adjacency struct {
+	index      *graph.Index       // The mapping between rows and node identifiers.
+	out        [][]int            // Targets of the edges leaving each node.
+	weight     [][]graph.Distance // Distances of the edges in `out`.
+	strength   [][]float64        // Collapsed weights of the edges in `out`.
+	in         [][]int            // Sources of the edges entering each node.
+	inWeight   [][]graph.Distance // Distances of the edges in `in`.
+	inStrength [][]float64        // Collapsed weights of the edges in `in`.
+	weighted   bool               // Whether edge weights must be taken into account.
+	negative   bool               // Whether any edge has a negative weight.
+	potential  []graph.Distance   // Johnson potentials the weights were shifted by, or nil.
+	directed   bool               // Whether the edges of the graph have a direction.
+	collapse   Collapse           // How parallel edges were combined.
+	version    uint64             // The version of the graph the view was built from.
+}
+
+// newAdjacency builds an adjacency-list view over a sparse snapshot of the graph.
+// The rows share memory with the snapshot, so no dense matrix is allocated.
+//
+// Parameters:
+//   - g: The graph to build the view from.
+//   - weightKey: The edge attribute used as the edge weight, or "" to use edge distances.
+//     Setting it makes the view weighted even for unweighted graph types.
+//   - collapse: How parallel edges are combined for degree, random-walk and spectral measures.
+//
+// Returns:
+//   - A pointer to the newly created adjacency view.
+func newAdjacency(g *graph.Graph, weightKey string, collapse Collapse) *adjacency {
+	csr := g.Adjacency(weightKey)
+	n := csr.Size()
+
+	adj := &adjacency{
+		index:      csr.Index(),
+		out:        make([][]int, n),
+		weight:     make([][]graph.Distance, n),
+		strength:   make([][]float64, n),
+		in:         make([][]int, n),
+		inWeight:   make([][]graph.Distance, n),
+		inStrength: make([][]float64, n),
+		weighted:   weightKey != "" || csr.Type() == graph.DIRECTED_WEIGHTED || csr.Type() == graph.UNDIRECTED_WEIGHTED,
+		directed:   csr.Type() == graph.DIRECTED_UNWEIGHTED || csr.Type() == graph.DIRECTED_WEIGHTED,
+		collapse:   collapse,
+		version:    csr.Version(),
+	}
+
+	for i := 0; i < n; i++ {
+		targets, weights := csr.Out(i)
+		adj.out[i], adj.weight[i], adj.strength[i] = adj.collapseRow(targets, weights)
+
+		sources, inWeights := csr.In(i)
+		adj.in[i], adj.inWeight[i], adj.inStrength[i] = adj.collapseRow(sources, inWeights)
+
+		for _, w := range adj.weight[i] {
+			if w < 0 {
+				adj.negative = adj.weighted
+			}
+		}
+	}
+
+	return adj
+}
+
+// collapseRow combines the parallel edges of one row, which are next to each other and sorted by weight.
+// A row without parallel edges is returned as is, sharing memory with the snapshot.
+//
+// Parameters:
+//   - targets: The target (or source) rows of the edges.
+//   - weights: The distances of the edges, aligned index by index.
+//
+// Returns:
+//   - The distinct targets.
+//   - The smallest distance towards every target.
+//   - The weight of every combined edge for degree, random-walk and spectral measures, according to adj.collapse.
+func (adj *adjacency) collapseRow(targets []int, weights []graph.Distance) ([]int, []graph.Distance, []float64) {
+	parallel := false
+
+	for k := 1; k < len(targets); k++ {
+		if targets[k] == targets[k-1] {
+			parallel = true
+			break
+		}
+	}
+
+	if !parallel {
+		strength := make([]float64, len(targets))
+
+		for k := range weights {
+			strength[k] = adj.edgeWeight(weights[k])
+		}
+
+		return targets, weights, strength
+	}
+
+	resultTargets := make([]int, 0, len(targets))
+	resultWeights := make([]graph.Distance, 0, len(targets))
+	strength := make([]float64, 0, len(targets))
+
+	for k := 0; k < len(targets); k++ {
+		if k == 0 || targets[k] != targets[k-1] {
+			// The first edge towards a target is the lightest one.
+			resultTargets = append(resultTargets, targets[k])
+			resultWeights = append(resultWeights, weights[k])
+
+			if adj.collapse == COLLAPSE_COUNT {
+				strength = append(strength, 1)
+			} else {
+				strength = append(strength, adj.edgeWeight(weights[k]))
+			}
+
+			continue
+		}
+
+		last := len(strength) - 1
+
+		switch adj.collapse {
+		case COLLAPSE_COUNT:
+			strength[last]++
+		case COLLAPSE_SUM:
+			strength[last] += adj.edgeWeight(weights[k])
+		}
+	}
+
+	return resultTargets, resultWeights, strength
+}
+
+// size returns the number of rows in the view, which is the number of nodes.
+func (adj *adjacency) size() int {
+	return len(adj.out)
+}
+
+// id returns the identifier of the node of the given row.
+func (adj *adjacency) id(v int) graph.NodeID {
+	return adj.index.ID(v)
+}
+
+// position returns the row of the node with the given identifier.
+//
+// Returns:
+//   - The row and true if the node exists, or -1 and false otherwise.
+func (adj *adjacency) position(identifier graph.NodeID) (int, bool) {
+	return adj.index.Position(identifier)
+}
+
+// reversed returns a view of the same graph with every edge pointing the other way.
+// The rows are shared with the original view, so neither may be modified.
+func (adj *adjacency) reversed() *adjacency {
+	var potential []graph.Distance
+
+	// A weight shifted by h[u] - h[v] along u->v is shifted by (-h)[v] - (-h)[u] along the reversed edge.
+	if adj.potential != nil {
+		potential = make([]graph.Distance, len(adj.potential))
+
+		for i, h := range adj.potential {
+			potential[i] = -h
+		}
+	}
+
+	return &adjacency{
+		index:      adj.index,
+		out:        adj.in,
+		weight:     adj.inWeight,
+		strength:   adj.inStrength,
+		in:         adj.out,
+		inWeight:   adj.weight,
+		inStrength: adj.strength,
+		weighted:   adj.weighted,
+		negative:   adj.negative,
+		potential:  potential,
+		directed:   adj.directed,
+		collapse:   adj.collapse,
+		version:    adj.version,
+	}
+}
+
+// degree returns the degree of a node, counting parallel edges according to adj.collapse.
+//
+// Parameters:
+//   - v: The row of the node.
+//   - incoming: If true, the edges entering the node are counted; otherwise the edges leaving it.
+//
+// Returns:
+//   - The number of neighbors for COLLAPSE_MIN, the number of edges for COLLAPSE_COUNT,
+//     or the total weight of the edges for COLLAPSE_SUM. A self-loop of an undirected graph counts twice.
+func (adj *adjacency) degree(v int, incoming bool) float64 {
+	rows, strength := adj.out, adj.strength
+
+	if incoming {
+		rows, strength = adj.in, adj.inStrength
+	}
+
+	total := 0.0
+
+	for k, w := range rows[v] {
+		value := strength[v][k]
+
+		if adj.collapse == COLLAPSE_MIN {
+			value = 1
+		}
+
+		// Both ends of an undirected self-loop touch the node.
+		if w == v && !adj.directed {
+			value *= 2
+		}
+
+		total += value
+	}
+
+	return total
+}
+
+// edgeWeight returns the weight used to follow an edge in random-walk and spectral measures.
+//
+// Parameters:
+//   - distance: The distance stored on the edge.
+//
+// Returns:
+//   - The distance as a float64, or 1 if the view is unweighted.
+func (adj *adjacency) edgeWeight(distance graph.Distance) float64 {
+	if !adj.weighted {
+		return 1
+	}
+
+	return float64(distance)
+}
+
+// hasEdge reports whether there is an edge from `from` to `to`, whatever its weight.
+// Rows are sorted, so the lookup is a binary search.
+func (adj *adjacency) hasEdge(from, to int) bool {
+	row := adj.out[from]
+	k := sort.SearchInts(row, to)
+
+	return k < len(row) && row[k] == to
+}
+
+// distance returns the smallest distance of the edges from `from` to `to`, or INF if there is none.
+func (adj *adjacency) distance(from, to int) graph.Distance {
+	row := adj.out[from]
+	k := sort.SearchInts(row, to)
+
+	if k < len(row) && row[k] == to {
+		return adj.weight[from][k]
+	}
+
+	return graph.INF
+}
+
+// neighbors returns the targets of the edges leaving a node, whatever their weight, other than the node itself.
+func (adj *adjacency) neighbors(v int) []int {
+	result := make([]int, 0, len(adj.out[v]))
+
+	for _, w := range adj.out[v] {
+		if w != v {
+			result = append(result, w)
+		}
+	}
+
+	return result
+}
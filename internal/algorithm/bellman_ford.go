@@ -0,0 +1,229 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-netrics/internal/graph"
+)
+
+// BellmanFord computes the shortest paths from one node to every node reachable from it for a Unit,
+// using the Bellman-Ford algorithm. Unlike Dijkstra's algorithm, it handles the negative weights
+// that DIRECTED_WEIGHTED graphs may hold.
+//
+// Parameters:
+//   - source: The identifier of the source node.
+//
+// Returns:
+//   - A map where the keys are the identifiers of the reachable nodes and the values are the shortest paths
+//     to them. The source itself is included with a path of distance 0.
+//   - A *graph.NotExistNodeError if the source does not exist, or a *NegativeCycleError carrying the cycle
+//     if a cycle of negative total weight is reachable from the source.
+func (u *Unit) BellmanFord(source graph.NodeID) (map[graph.NodeID]graph.Path, error) {
+	return bellmanFordPaths(u.snapshot(), source)
+}
+
+// BellmanFord computes the shortest paths from one node to every node reachable from it for a ParallelUnit.
+// Every relaxation round depends on the previous one, so the computation is sequential;
+// the method is provided for API symmetry with Unit.
+//
+// Parameters:
+//   - source: The identifier of the source node.
+//
+// Returns:
+//   - A map where the keys are the identifiers of the reachable nodes and the values are the shortest paths
+//     to them. The source itself is included with a path of distance 0.
+//   - A *graph.NotExistNodeError if the source does not exist, or a *NegativeCycleError carrying the cycle
+//     if a cycle of negative total weight is reachable from the source.
+func (pu *ParallelUnit) BellmanFord(source graph.NodeID) (map[graph.NodeID]graph.Path, error) {
+	return bellmanFordPaths(pu.snapshot(), source)
+}
+
+// bellmanFordPaths runs the Bellman-Ford algorithm from one node and collects the paths it found.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - source: The identifier of the source node.
+//
+// Returns:
+//   - The shortest paths keyed by destination, and an error as described in Unit.BellmanFord.
+func bellmanFordPaths(adj *adjacency, source graph.NodeID) (map[graph.NodeID]graph.Path, error) {
+	start, ok := adj.position(source)
+
+	if !ok {
+		return nil, &graph.NotExistNodeError{ID: source}
+	}
+
+	dist, prev := unreached(adj.size())
+	dist[start] = 0
+
+	if v := bellmanFord(adj, dist, prev); v != -1 {
+		return nil, &NegativeCycleError{Cycle: cyclePath(adj, prev, v)}
+	}
+
+	result := make(map[graph.NodeID]graph.Path)
+
+	for end, d := range dist {
+		if d != graph.INF {
+			result[adj.id(end)] = *graph.NewPath(d, tracePath(adj, prev, end))
+		}
+	}
+
+	return result, nil
+}
+
+// unreached allocates the distance and predecessor slices of a single-source search,
+// with every node unreachable and without a predecessor.
+func unreached(n int) ([]graph.Distance, []int) {
+	dist := make([]graph.Distance, n)
+	prev := make([]int, n)
+
+	for i := range dist {
+		dist[i] = graph.INF
+		prev[i] = -1
+	}
+
+	return dist, prev
+}
+
+// bellmanFord relaxes every edge of the graph until no distance improves, for at most one round per node.
+// The distances are updated in place, so several sources can be searched at once by starting them at 0.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - dist: The initial distance of every node, INF for nodes that are not a source. Updated in place.
+//   - prev: The predecessor of every node, initially -1. Updated in place.
+//
+// Returns:
+//   - -1 if the distances settled, or a node that was still improved in the last round,
+//     which means a negative cycle is reachable and leads to it through `prev`.
+func bellmanFord(adj *adjacency, dist []graph.Distance, prev []int) int {
+	n := adj.size()
+	changed := -1
+
+	for round := 0; round < n; round++ {
+		changed = -1
+
+		for u := 0; u < n; u++ {
+			if dist[u] == graph.INF {
+				continue
+			}
+
+			for k, v := range adj.out[u] {
+				if alt := dist[u] + adj.weight[u][k]; alt < dist[v] {
+					dist[v] = alt
+					prev[v] = u
+					changed = v
+				}
+			}
+		}
+
+		if changed == -1 {
+			break
+		}
+	}
+
+	return changed
+}
+
+// cyclePath extracts the negative cycle that a node left improving by bellmanFord leads to.
+//
+// Parameters:
+//   - adj: The adjacency view the search ran on.
+//   - prev: The predecessors left by the search.
+//   - v: The node returned by bellmanFord.
+//
+// Returns:
+//   - The cycle in the direction of its edges, starting and ending at the same node, with its total weight.
+func cyclePath(adj *adjacency, prev []int, v int) graph.Path {
+	// Following n predecessor links is guaranteed to end on the cycle rather than on the way to it.
+	for i := 0; i < adj.size(); i++ {
+		v = prev[v]
+	}
+
+	rows := []int{v}
+
+	for at := prev[v]; at != v; at = prev[at] {
+		rows = append(rows, at)
+	}
+
+	rows = append(rows, v)
+	nodes := make([]graph.NodeID, len(rows))
+	total := graph.Distance(0)
+
+	// The predecessor links run against the edges, so the rows are read backwards.
+	for i := range rows {
+		nodes[i] = adj.id(rows[len(rows)-1-i])
+
+		if i > 0 {
+			total += adj.distance(rows[len(rows)-i], rows[len(rows)-1-i])
+		}
+	}
+
+	return *graph.NewPath(total, nodes)
+}
+
+// johnson reweights a view with negative weights so that Dijkstra's algorithm can search it.
+// A Bellman-Ford search from a virtual source linked to every node yields potentials h,
+// and every edge u->v is shifted to w + h[u] - h[v], which is non-negative and keeps the same shortest paths.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//
+// Returns:
+//   - The reweighted view, sharing everything but the weights with `adj`.
+//     Distances found on it are corrected by shortest.
+//   - A *NegativeCycleError if the graph has a cycle of negative total weight.
+func johnson(adj *adjacency) (*adjacency, error) {
+	n := adj.size()
+	potential := make([]graph.Distance, n) // Every node starts at distance 0 from the virtual source.
+	_, prev := unreached(n)
+
+	if v := bellmanFord(adj, potential, prev); v != -1 {
+		return nil, &NegativeCycleError{Cycle: cyclePath(adj, prev, v)}
+	}
+
+	shift := func(from, to int, w graph.Distance) graph.Distance {
+		// Rounding may leave a tiny negative value where the exact result is 0.
+		if w = w + potential[from] - potential[to]; w < 0 {
+			return 0
+		}
+
+		return w
+	}
+
+	result := *adj
+	result.weight = make([][]graph.Distance, n)
+	result.inWeight = make([][]graph.Distance, n)
+	result.negative = false
+	result.potential = potential
+
+	for v := 0; v < n; v++ {
+		result.weight[v] = make([]graph.Distance, len(adj.out[v]))
+
+		for k, w := range adj.out[v] {
+			result.weight[v][k] = shift(v, w, adj.weight[v][k])
+		}
+
+		result.inWeight[v] = make([]graph.Distance, len(adj.in[v]))
+
+		for k, w := range adj.in[v] {
+			result.inWeight[v][k] = shift(w, v, adj.inWeight[v][k])
+		}
+	}
+
+	return &result, nil
+}
+
+// pathView returns the view that shortest path searches run on: the view itself,
+// or its Johnson reweighting if it has negative weights.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//
+// Returns:
+//   - The view to search, and a *NegativeCycleError if the graph has a cycle of negative total weight.
+func pathView(adj *adjacency) (*adjacency, error) {
+	if !adj.negative {
+		return adj, nil
+	}
+
+	return johnson(adj)
+}
@@ -0,0 +1,303 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/elecbug/go-netrics/internal/graph"
+)
+
+// brandesState holds the result of one single-source traversal used by Brandes' algorithm.
+//
+// Fields:
+//   - order: The nodes reachable from the source, each after all of its predecessors.
+//   - pred: The predecessors of each node over all of its shortest paths from the source.
+//   - sigma: The number of shortest paths from the source to each node.
+type brandesState struct {
+	order []int     // Reachable nodes, each after its predecessors.
+	pred  [][]int   // Predecessors of each node on its shortest paths.
+	sigma []float64 // Number of shortest paths from the source to each node.
+}
+
+// brandesTraverse runs a single-source traversal from `start` that counts all shortest paths.
+// BFS is used for unweighted graphs and Dijkstra's algorithm for weighted ones,
+// so a view with negative weights must be reweighted by pathView first.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - start: The row of the source node.
+//
+// Returns:
+//   - The traversal state for accumulating dependencies.
+func brandesTraverse(adj *adjacency, start int) *brandesState {
+	n := adj.size()
+	state := &brandesState{
+		order: make([]int, 0, n),
+		pred:  make([][]int, n),
+		sigma: make([]float64, n),
+	}
+
+	state.sigma[start] = 1
+
+	if !adj.weighted {
+		dist := make([]int, n)
+
+		for i := range dist {
+			dist[i] = -1
+		}
+
+		dist[start] = 0
+		queue := []int{start}
+
+		for head := 0; head < len(queue); head++ {
+			v := queue[head]
+			state.order = append(state.order, v)
+
+			for _, w := range adj.out[v] {
+				if dist[w] < 0 {
+					dist[w] = dist[v] + 1
+					queue = append(queue, w)
+				}
+
+				if dist[w] == dist[v]+1 {
+					state.sigma[w] += state.sigma[v]
+					state.pred[w] = append(state.pred[w], v)
+				}
+			}
+		}
+
+		return state
+	}
+
+	// Counting paths while Dijkstra's algorithm runs misses ties through zero-weight edges, which can reach
+	// a node after it is settled, so the shortest path edges are only collected from the final distances.
+	dist, _ := dijkstra(adj, start)
+	tight := func(v, k int) bool {
+		w := adj.out[v][k]
+		return w != v && dist[v] < graph.INF && dist[v]+adj.weight[v][k] == dist[w]
+	}
+
+	reached := make([]int, 0, n)
+	pending := make([]int, n)
+
+	for v := range adj.out {
+		if dist[v] == graph.INF {
+			continue
+		}
+
+		reached = append(reached, v)
+
+		for k, w := range adj.out[v] {
+			if tight(v, k) {
+				pending[w]++
+			}
+		}
+	}
+
+	// Nodes are ordered once every shortest path edge into them has been counted.
+	ordered := make([]bool, n)
+	ordered[start] = true
+	queue := []int{start}
+	nearest := 0
+
+	sort.SliceStable(reached, func(i, j int) bool { return dist[reached[i]] < dist[reached[j]] })
+
+	for head := 0; len(state.order) < len(reached); head++ {
+		if head == len(queue) {
+			// Only a cycle of zero-weight edges is left waiting; it is broken at its nearest node.
+			for ordered[reached[nearest]] {
+				nearest++
+			}
+
+			ordered[reached[nearest]] = true
+			queue = append(queue, reached[nearest])
+		}
+
+		v := queue[head]
+		state.order = append(state.order, v)
+
+		for k, w := range adj.out[v] {
+			if ordered[w] || !tight(v, k) {
+				continue
+			}
+
+			state.sigma[w] += state.sigma[v]
+			state.pred[w] = append(state.pred[w], v)
+
+			if pending[w]--; pending[w] == 0 {
+				ordered[w] = true
+				queue = append(queue, w)
+			}
+		}
+	}
+
+	return state
+}
+
+// accumulateNodes adds the node dependencies of one traversal to the betweenness scores.
+//
+// Parameters:
+//   - state: The traversal state from brandesTraverse.
+//   - start: The row of the source node.
+//   - endpoints: If true, the endpoints of each path are counted as lying on it.
+//   - scores: The betweenness scores indexed by row, updated in place.
+func accumulateNodes(state *brandesState, start int, endpoints bool, scores []float64) {
+	delta := make([]float64, len(state.sigma))
+
+	if endpoints {
+		scores[start] += float64(len(state.order) - 1)
+	}
+
+	// Visit nodes from the farthest to the nearest, pushing dependencies to predecessors.
+	for i := len(state.order) - 1; i >= 0; i-- {
+		w := state.order[i]
+
+		for _, v := range state.pred[w] {
+			delta[v] += state.sigma[v] / state.sigma[w] * (1 + delta[w])
+		}
+
+		if w != start {
+			scores[w] += delta[w]
+
+			if endpoints {
+				scores[w]++
+			}
+		}
+	}
+}
+
+// accumulateEdges adds the edge dependencies of one traversal to the edge betweenness scores.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph. Undirected edges are keyed with the smaller identifier first.
+//   - state: The traversal state from brandesTraverse.
+//   - scores: The edge betweenness scores keyed by edge, updated in place.
+func accumulateEdges(adj *adjacency, state *brandesState, scores map[graph.EdgeKey]float64) {
+	delta := make([]float64, len(state.sigma))
+
+	// Visit nodes from the farthest to the nearest, crediting every edge on a shortest path.
+	for i := len(state.order) - 1; i >= 0; i-- {
+		w := state.order[i]
+
+		for _, v := range state.pred[w] {
+			c := state.sigma[v] / state.sigma[w] * (1 + delta[w])
+			scores[graph.NewEdgeKey(adj.id(v), adj.id(w), adj.directed)] += c
+			delta[v] += c
+		}
+	}
+}
+
+// scaleBetweenness converts raw Brandes scores into the requested normalization.
+// Raw scores count ordered pairs, so for undirected graphs they are halved to count every pair once.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - scores: The raw scores indexed by row.
+//   - endpoints: Whether endpoints were counted in the raw scores.
+//   - normalization: How the scores are scaled.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the scaled scores.
+func scaleBetweenness(adj *adjacency, scores []float64, endpoints bool, normalization Normalization) map[graph.NodeID]float64 {
+	n := adj.size()
+
+	scale := 1.0
+
+	switch normalization {
+	case NORMALIZE_NONE:
+		if !adj.directed {
+			scale = 0.5
+		}
+	case NORMALIZE_PAIRS:
+		// Raw scores count ordered pairs, so dividing by the number of ordered pairs suits both graph types.
+		if endpoints && n > 1 {
+			scale = 1 / float64(n*(n-1))
+		} else if !endpoints && n > 2 {
+			scale = 1 / float64((n-1)*(n-2))
+		}
+	case NORMALIZE_MAX:
+		max := 0.0
+
+		for _, value := range scores {
+			if value > max {
+				max = value
+			}
+		}
+
+		if max > 0 {
+			scale = 1 / max
+		}
+	}
+
+	result := make(map[graph.NodeID]float64)
+
+	for i, value := range scores {
+		result[adj.id(i)] = value * scale
+	}
+
+	return result
+}
+
+// scaleEdgeBetweenness converts raw edge betweenness scores into the requested normalization in place.
+// Raw scores count ordered pairs, so for undirected graphs they are halved to count every pair once.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - scores: The raw scores keyed by edge.
+//   - normalization: How the scores are scaled.
+//
+// Returns:
+//   - The scaled scores.
+func scaleEdgeBetweenness(adj *adjacency, scores map[graph.EdgeKey]float64, normalization Normalization) map[graph.EdgeKey]float64 {
+	n := adj.size()
+
+	scale := 1.0
+
+	switch normalization {
+	case NORMALIZE_NONE:
+		if !adj.directed {
+			scale = 0.5
+		}
+	case NORMALIZE_PAIRS:
+		// Every ordered pair of distinct nodes can route through an edge.
+		if n > 1 {
+			scale = 1 / float64(n*(n-1))
+		}
+	case NORMALIZE_MAX:
+		max := 0.0
+
+		for _, value := range scores {
+			if value > max {
+				max = value
+			}
+		}
+
+		if max > 0 {
+			scale = 1 / max
+		}
+	}
+
+	for key := range scores {
+		scores[key] *= scale
+	}
+
+	return scores
+}
+
+// edgeKeys creates a score map holding every edge of the graph with a score of 0.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph. Undirected edges are keyed with the smaller identifier first.
+//
+// Returns:
+//   - A map where the keys are the edges of the graph.
+func edgeKeys(adj *adjacency) map[graph.EdgeKey]float64 {
+	scores := make(map[graph.EdgeKey]float64)
+
+	for v, targets := range adj.out {
+		for _, w := range targets {
+			scores[graph.NewEdgeKey(adj.id(v), adj.id(w), adj.directed)] = 0
+		}
+	}
+
+	return scores
+}
@@ -1,6 +1,7 @@
 package algorithm
 
 import (
+	"fmt"
 	"math"
 	"sync"
 
@@ -9,141 +10,239 @@ import (
 
 // BetweennessCentrality computes the betweenness centrality of each node in the graph for a Unit.
 // Betweenness centrality measures how often a node appears on the shortest paths between pairs of other nodes.
+// It is equivalent to BetweennessCentralityWithOptions(false, NORMALIZE_PAIRS).
 //
 // Returns:
 //   - A map where the keys are node identifiers and the values are the betweenness centrality scores.
 func (u *Unit) BetweennessCentrality() map[graph.NodeID]float64 {
-	g := u.graph
+	return u.BetweennessCentralityWithOptions(false, NORMALIZE_PAIRS)
+}
 
-	if !g.IsUpdated() || !u.updated {
-		// Recompute shortest paths if the graph or unit has been updated.
-		u.computePaths()
+// BetweennessCentrality computes the betweenness centrality of each node in the graph for a ParallelUnit.
+// The computation is performed in parallel for better performance on larger graphs.
+// It is equivalent to BetweennessCentralityWithOptions(false, NORMALIZE_PAIRS).
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the betweenness centrality scores.
+func (pu *ParallelUnit) BetweennessCentrality() map[graph.NodeID]float64 {
+	return pu.BetweennessCentralityWithOptions(false, NORMALIZE_PAIRS)
+}
+
+// BetweennessCentralityWithOptions computes the betweenness centrality of each node in the graph for a Unit
+// using Brandes' algorithm. All shortest paths between a pair of nodes are counted, so a node lying on
+// some of several equally short paths receives the matching fraction of that pair.
+//
+// Parameters:
+//   - endpoints: If true, the source and target of each path are also counted as lying on it.
+//   - normalization: How the raw scores are scaled (see Normalization).
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the betweenness centrality scores.
+//
+// Notes:
+//   - Negative weights are handled with Johnson's algorithm. If the graph has a negative cycle,
+//     no shortest path exists and every score is 0; PathError reports the cycle.
+func (u *Unit) BetweennessCentralityWithOptions(endpoints bool, normalization Normalization) map[graph.NodeID]float64 {
+	view := u.snapshot()
+	adj, err := pathView(view)
+	scores := make([]float64, view.size())
+
+	if err != nil {
+		// A negative cycle leaves no shortest path for any node to lie on.
+		return scaleBetweenness(view, scores, endpoints, normalization)
 	}
 
-	centrality := make(map[graph.NodeID]float64)
+	// Run one traversal per source and accumulate its dependencies.
+	for start := 0; start < adj.size(); start++ {
+		accumulateNodes(brandesTraverse(adj, start), start, endpoints, scores)
+	}
+
+	return scaleBetweenness(adj, scores, endpoints, normalization)
+}
 
-	// Initialize centrality scores for all nodes to 0.
-	for i := 0; i < g.NodeCount(); i++ {
-		centrality[graph.NodeID(i)] = 0
+// BetweennessCentralityWithOptions computes the betweenness centrality of each node in the graph for a ParallelUnit
+// using Brandes' algorithm. Source nodes are distributed across the workers, each of which accumulates
+// its own partial scores before they are summed.
+//
+// Parameters:
+//   - endpoints: If true, the source and target of each path are also counted as lying on it.
+//   - normalization: How the raw scores are scaled (see Normalization).
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the betweenness centrality scores.
+//
+// Notes:
+//   - Negative weights are handled with Johnson's algorithm. If the graph has a negative cycle,
+//     no shortest path exists and every score is 0; PathError reports the cycle.
+func (pu *ParallelUnit) BetweennessCentralityWithOptions(endpoints bool, normalization Normalization) map[graph.NodeID]float64 {
+	view := pu.snapshot()
+	adj, err := pathView(view)
+	n := view.size()
+
+	if err != nil {
+		// A negative cycle leaves no shortest path for any node to lie on.
+		return scaleBetweenness(view, make([]float64, n), endpoints, normalization)
 	}
 
-	// Count how many times each node appears on the shortest paths.
-	for _, path := range u.shortestPaths {
-		nodes := path.Nodes()
+	workerCount := pu.maxCore
 
-		for _, n := range nodes {
-			// Exclude the source and target nodes of the path.
-			if n != nodes[0] && n != nodes[len(nodes)-1] {
-				centrality[n]++
+	if workerCount == 0 {
+		workerCount = 1
+	}
+
+	jobChan := make(chan int)
+	partials := make([][]float64, workerCount) // Partial scores owned by each worker.
+	var wg sync.WaitGroup
+
+	// Start worker goroutines, each accumulating into its own partial scores.
+	for i := uint(0); i < workerCount; i++ {
+		partials[i] = make([]float64, n)
+		wg.Add(1)
+
+		go func(scores []float64) {
+			defer wg.Done()
+			for start := range jobChan {
+				accumulateNodes(brandesTraverse(adj, start), start, endpoints, scores)
 			}
-		}
+		}(partials[i])
 	}
 
-	// Normalize the centrality scores.
-	n := g.NodeCount()
-	if n > 2 {
-		for node := range centrality {
-			centrality[node] /= float64((n - 1) * (n - 2))
+	// Generate one job for every live source node.
+	for start := 0; start < n; start++ {
+		jobChan <- start
+	}
+	close(jobChan)
+
+	wg.Wait()
+
+	// Sum the partial scores of all workers.
+	scores := make([]float64, n)
+
+	for _, partial := range partials {
+		for i, value := range partial {
+			scores[i] += value
 		}
 	}
 
-	return centrality
+	return scaleBetweenness(adj, scores, endpoints, normalization)
 }
 
-// BetweennessCentrality computes the betweenness centrality of each node in the graph for a ParallelUnit.
-// The computation is performed in parallel for better performance on larger graphs.
+// EdgeBetweennessCentrality computes the betweenness centrality of each edge in the graph for a Unit
+// using Brandes' algorithm. Edge betweenness measures how often an edge lies on the shortest paths
+// between pairs of nodes, which makes it useful for finding bridges between communities.
+//
+// Parameters:
+//   - normalization: How the raw scores are scaled (see Normalization).
 //
 // Returns:
-//   - A map where the keys are node identifiers and the values are the betweenness centrality scores.
-func (pu *ParallelUnit) BetweennessCentrality() map[graph.NodeID]float64 {
-	g := pu.graph
+//   - A map where the keys are edges and the values are the edge betweenness scores.
+//     For undirected graphs every edge appears once, keyed with the smaller identifier first.
+//
+// Notes:
+//   - Negative weights are handled with Johnson's algorithm. If the graph has a negative cycle,
+//     no shortest path exists and every score is 0; PathError reports the cycle.
+func (u *Unit) EdgeBetweennessCentrality(normalization Normalization) map[graph.EdgeKey]float64 {
+	view := u.snapshot()
+	adj, err := pathView(view)
+	scores := edgeKeys(view)
+
+	if err != nil {
+		// A negative cycle leaves no shortest path for any edge to lie on.
+		return scaleEdgeBetweenness(view, scores, normalization)
+	}
 
-	if !g.IsUpdated() || !pu.updated {
-		// Recompute shortest paths if the graph or unit has been updated.
-		pu.computePaths()
+	// Run one traversal per source and accumulate its dependencies.
+	for start := 0; start < adj.size(); start++ {
+		accumulateEdges(adj, brandesTraverse(adj, start), scores)
 	}
 
-	centrality := make(map[graph.NodeID]float64)
+	return scaleEdgeBetweenness(adj, scores, normalization)
+}
 
-	// Initialize centrality scores for all nodes to 0.
-	for i := 0; i < g.NodeCount(); i++ {
-		centrality[graph.NodeID(i)] = 0
+// EdgeBetweennessCentrality computes the betweenness centrality of each edge in the graph for a ParallelUnit
+// using Brandes' algorithm. Source nodes are distributed across the workers, each of which accumulates
+// its own partial scores before they are summed.
+//
+// Parameters:
+//   - normalization: How the raw scores are scaled (see Normalization).
+//
+// Returns:
+//   - A map where the keys are edges and the values are the edge betweenness scores.
+//     For undirected graphs every edge appears once, keyed with the smaller identifier first.
+//
+// Notes:
+//   - Negative weights are handled with Johnson's algorithm. If the graph has a negative cycle,
+//     no shortest path exists and every score is 0; PathError reports the cycle.
+func (pu *ParallelUnit) EdgeBetweennessCentrality(normalization Normalization) map[graph.EdgeKey]float64 {
+	view := pu.snapshot()
+	adj, err := pathView(view)
+
+	if err != nil {
+		// A negative cycle leaves no shortest path for any edge to lie on.
+		return scaleEdgeBetweenness(view, edgeKeys(view), normalization)
 	}
 
-	// Define a result type to collect intermediate centrality counts.
-	type result struct {
-		node  graph.NodeID
-		count float64
+	workerCount := pu.maxCore
+
+	if workerCount == 0 {
+		workerCount = 1
 	}
 
-	resultChan := make(chan result, g.NodeCount())
+	jobChan := make(chan int)
+	partials := make([]map[graph.EdgeKey]float64, workerCount) // Partial scores owned by each worker.
 	var wg sync.WaitGroup
 
-	// Compute centrality scores in parallel.
-	for _, path := range pu.shortestPaths {
+	// Start worker goroutines, each accumulating into its own partial scores.
+	for i := uint(0); i < workerCount; i++ {
+		partials[i] = make(map[graph.EdgeKey]float64)
 		wg.Add(1)
 
-		go func(path graph.Path) {
+		go func(scores map[graph.EdgeKey]float64) {
 			defer wg.Done()
-			nodes := path.Nodes()
-
-			for _, n := range nodes {
-				// Exclude the source and target nodes of the path.
-				if n != nodes[0] && n != nodes[len(nodes)-1] {
-					resultChan <- result{node: n, count: 1}
-				}
+			for start := range jobChan {
+				accumulateEdges(adj, brandesTraverse(adj, start), scores)
 			}
-		}(path)
+		}(partials[i])
 	}
 
-	// Close the result channel after all goroutines complete.
-	go func() {
-		wg.Wait()
-		close(resultChan)
-	}()
-
-	// Aggregate results from the result channel.
-	for res := range resultChan {
-		centrality[res.node] += res.count
+	// Generate one job for every live source node.
+	for start := 0; start < adj.size(); start++ {
+		jobChan <- start
 	}
+	close(jobChan)
 
-	// Normalize the centrality scores.
-	n := g.NodeCount()
-	if n > 2 {
-		for node := range centrality {
-			centrality[node] /= float64((n - 1) * (n - 2))
+	wg.Wait()
+
+	// Sum the partial scores of all workers.
+	scores := edgeKeys(adj)
+
+	for _, partial := range partials {
+		for key, value := range partial {
+			scores[key] += value
 		}
 	}
 
-	return centrality
+	return scaleEdgeBetweenness(adj, scores, normalization)
 }
 
 // DegreeCentrality computes the degree centrality of each node in the graph for a Unit.
 // Degree centrality is the number of direct connections a node has to other nodes.
+// In a multigraph, parallel edges are counted according to the unit's Collapse policy.
+// A self-loop adds 2 to the degree of a node in an undirected graph, and 1 to each degree in a directed graph.
 //
 // Returns:
 //   - A map where the keys are node identifiers and the values are the degree centrality scores.
 func (u *Unit) DegreeCentrality() map[graph.NodeID]float64 {
-	g := u.graph
+	adj := u.snapshot()
 	centrality := make(map[graph.NodeID]float64)
 
-	// Initialize centrality scores for all nodes to 0.
-	for i := 0; i < g.NodeCount(); i++ {
-		centrality[graph.NodeID(i)] = 0
-	}
-
 	// Calculate the degree for each node by counting direct neighbors.
-	matrix := g.Matrix()
-	for i, row := range matrix {
-		for _, value := range row {
-			if value != graph.INF {
-				centrality[graph.NodeID(i)]++
-			}
-		}
+	for i := range adj.out {
+		centrality[adj.id(i)] = adj.degree(i, false)
 	}
 
 	// Normalize centrality scores by the maximum possible degree (n-1).
-	n := g.NodeCount()
+	n := adj.size()
 	if n > 1 {
 		for node := range centrality {
 			centrality[node] /= float64(n - 1)
@@ -159,71 +258,106 @@ func (u *Unit) DegreeCentrality() map[graph.NodeID]float64 {
 // Returns:
 //   - A map where the keys are node identifiers and the values are the degree centrality scores.
 func (pu *ParallelUnit) DegreeCentrality() map[graph.NodeID]float64 {
-	g := pu.graph
-	centrality := make(map[graph.NodeID]float64)
+	adj := pu.snapshot()
 
-	// Initialize centrality scores for all nodes to 0.
-	for i := 0; i < g.NodeCount(); i++ {
-		centrality[graph.NodeID(i)] = 0
-	}
+	// Compute degree centrality in parallel, normalized by the maximum possible degree (n-1).
+	return degreeCentrality(adj, false, pu.maxCore)
+}
 
-	matrix := g.Matrix()
-	var wg sync.WaitGroup
-	resultChan := make(chan struct {
-		node  graph.NodeID
-		count float64
-	}, g.NodeCount())
+// InDegreeCentrality computes the in-degree centrality of each node in the graph for a Unit.
+// In-degree centrality is the number of edges entering a node, normalized by the maximum possible degree (n-1).
+// The degrees are read from the incoming-edge index, so no edge list has to be scanned.
+// For undirected graphs the result equals DegreeCentrality.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the in-degree centrality scores.
+func (u *Unit) InDegreeCentrality() map[graph.NodeID]float64 {
+	adj := u.snapshot()
+	return degreeCentrality(adj, true, 1)
+}
 
-	// Compute degree centrality in parallel.
-	for i := 0; i < len(matrix); i++ {
-		wg.Add(1)
+// InDegreeCentrality computes the in-degree centrality of each node in the graph for a ParallelUnit.
+// The computation is performed in parallel for better performance on larger graphs.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the in-degree centrality scores.
+func (pu *ParallelUnit) InDegreeCentrality() map[graph.NodeID]float64 {
+	adj := pu.snapshot()
+	return degreeCentrality(adj, true, pu.maxCore)
+}
 
-		go func(nodeIndex int) {
-			defer wg.Done()
-			count := 0.0
-			for _, value := range matrix[nodeIndex] {
-				if value != graph.INF {
-					count++
-				}
-			}
-			resultChan <- struct {
-				node  graph.NodeID
-				count float64
-			}{node: graph.NodeID(nodeIndex), count: count}
-		}(i)
-	}
+// OutDegreeCentrality computes the out-degree centrality of each node in the graph for a Unit.
+// Out-degree centrality is the number of edges leaving a node, normalized by the maximum possible degree (n-1).
+// It equals DegreeCentrality, and is provided to pair with InDegreeCentrality.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the out-degree centrality scores.
+func (u *Unit) OutDegreeCentrality() map[graph.NodeID]float64 {
+	adj := u.snapshot()
+	return degreeCentrality(adj, false, 1)
+}
 
-	// Close the result channel after all goroutines complete.
-	go func() {
-		wg.Wait()
-		close(resultChan)
-	}()
+// OutDegreeCentrality computes the out-degree centrality of each node in the graph for a ParallelUnit.
+// The computation is performed in parallel for better performance on larger graphs.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the out-degree centrality scores.
+func (pu *ParallelUnit) OutDegreeCentrality() map[graph.NodeID]float64 {
+	adj := pu.snapshot()
+	return degreeCentrality(adj, false, pu.maxCore)
+}
 
-	// Aggregate results from the result channel.
-	for res := range resultChan {
-		centrality[res.node] = res.count
-	}
+// degreeCentrality computes the normalized degree of every node in one direction.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - incoming: If true, in-degrees are computed; otherwise out-degrees.
+//   - workers: The number of goroutines to use.
+//
+// Returns:
+//   - The degree centrality scores keyed by node identifier.
+func degreeCentrality(adj *adjacency, incoming bool, workers uint) map[graph.NodeID]float64 {
+	n := adj.size()
 
-	// Normalize centrality scores by the maximum possible degree (n-1).
-	n := g.NodeCount()
-	if n > 1 {
-		for node := range centrality {
-			centrality[node] /= float64(n - 1)
+	return nodeScores(adj, workers, func(v int) float64 {
+		if n > 1 {
+			return adj.degree(v, incoming) / float64(n-1)
 		}
-	}
 
-	return centrality
+		return adj.degree(v, incoming)
+	})
 }
 
 // EigenvectorCentrality computes the eigenvector centrality of each node in the graph for a Unit.
 // Eigenvector centrality assigns scores to nodes based on the importance of their neighbors.
+// A self-loop is the diagonal entry of the adjacency matrix, so a node contributes to its own score.
 //
 // Returns:
 //   - A map where the keys are node identifiers and the values are the eigenvector centrality scores.
 func (u *Unit) EigenvectorCentrality(maxIter int, tol float64) map[graph.NodeID]float64 {
-	g := u.graph
-	matrix := g.Matrix()
-	n := len(matrix)
+	return eigenvectorCentrality(u.snapshot(), 1, maxIter, tol)
+}
+
+// EigenvectorCentrality computes the eigenvector centrality of each node in the graph for a ParallelUnit.
+// The computation is performed in parallel for better performance on larger graphs.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the eigenvector centrality scores.
+func (pu *ParallelUnit) EigenvectorCentrality(maxIter int, tol float64) map[graph.NodeID]float64 {
+	return eigenvectorCentrality(pu.snapshot(), pu.maxCore, maxIter, tol)
+}
+
+// eigenvectorCentrality runs the eigenvector power iteration over the adjacency view.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - workers: The number of goroutines used per iteration.
+//   - maxIter, tol: See Unit.EigenvectorCentrality.
+//
+// Returns:
+//   - The eigenvector centrality scores keyed by node identifier.
+func eigenvectorCentrality(adj *adjacency, workers uint, maxIter int, tol float64) map[graph.NodeID]float64 {
+	n := adj.size()
 
 	// Initialize centrality scores with 1/n
 	centrality := make([]float64, n)
@@ -234,24 +368,18 @@ func (u *Unit) EigenvectorCentrality(maxIter int, tol float64) map[graph.NodeID]
 	for iter := 0; iter < maxIter; iter++ {
 		newCentrality := make([]float64, n)
 
-		// Update centrality scores
-		for i := 0; i < n; i++ {
-			for j := 0; j < n; j++ {
-				if matrix[i][j] != graph.INF {
-					newCentrality[i] += float64(matrix[i][j].ToInt()) * centrality[j]
+		// Update centrality scores from the outgoing edges.
+		parallelRange(n, workers, func(lo, hi int) {
+			for i := lo; i < hi; i++ {
+				for k, j := range adj.out[i] {
+					newCentrality[i] += adj.strength[i][k] * centrality[j]
 				}
 			}
-		}
+		})
 
 		// Normalize the new centrality scores
-		norm := 0.0
-		for _, value := range newCentrality {
-			norm += value * value
-		}
-		norm = math.Sqrt(norm)
-
-		for i := 0; i < n; i++ {
-			newCentrality[i] /= norm
+		if !normalize(newCentrality, 2) {
+			break
 		}
 
 		// Check for convergence
@@ -270,77 +398,308 @@ func (u *Unit) EigenvectorCentrality(maxIter int, tol float64) map[graph.NodeID]
 	// Convert to map for output
 	result := make(map[graph.NodeID]float64)
 	for i := 0; i < n; i++ {
-		result[graph.NodeID(i)] = centrality[i]
+		result[adj.id(i)] = centrality[i]
 	}
 
 	return result
 }
 
-// EigenvectorCentrality computes the eigenvector centrality of each node in the graph for a ParallelUnit.
-// The computation is performed in parallel for better performance on larger graphs.
+// KatzCentrality computes the Katz centrality of each node in the graph for a Unit.
+// Katz centrality generalizes eigenvector centrality by giving every node a baseline score `beta`
+// and attenuating the influence of longer walks by `alpha`. For directed graphs, incoming edges are followed.
+//
+// Parameters:
+//   - alpha: The attenuation factor. It must be positive and smaller than 1/λ, where λ is the spectral radius of the adjacency matrix.
+//   - beta: The baseline score given to every node.
+//   - maxIter: The maximum number of iterations.
+//   - tol: The convergence threshold on the L1 difference between successive iterates.
 //
 // Returns:
-//   - A map where the keys are node identifiers and the values are the eigenvector centrality scores.
-func (pu *ParallelUnit) EigenvectorCentrality(maxIter int, tol float64) map[graph.NodeID]float64 {
-	g := pu.graph
-	matrix := g.Matrix()
-	n := len(matrix)
+//   - A map where the keys are node identifiers and the values are the Katz centrality scores, normalized to unit length.
+//   - An error if alpha is out of range or if the iteration does not converge within maxIter iterations.
+func (u *Unit) KatzCentrality(alpha, beta float64, maxIter int, tol float64) (map[graph.NodeID]float64, error) {
+	return katzCentrality(u.snapshot(), 1, alpha, beta, maxIter, tol)
+}
 
-	// Initialize centrality scores with 1/n
-	centrality := make([]float64, n)
-	for i := 0; i < n; i++ {
-		centrality[i] = 1.0 / float64(n)
+// KatzCentrality computes the Katz centrality of each node in the graph for a ParallelUnit.
+// Each iteration updates disjoint ranges of nodes in parallel.
+//
+// Parameters:
+//   - alpha: The attenuation factor. It must be positive and smaller than 1/λ, where λ is the spectral radius of the adjacency matrix.
+//   - beta: The baseline score given to every node.
+//   - maxIter: The maximum number of iterations.
+//   - tol: The convergence threshold on the L1 difference between successive iterates.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the Katz centrality scores, normalized to unit length.
+//   - An error if alpha is out of range or if the iteration does not converge within maxIter iterations.
+func (pu *ParallelUnit) KatzCentrality(alpha, beta float64, maxIter int, tol float64) (map[graph.NodeID]float64, error) {
+	return katzCentrality(pu.snapshot(), pu.maxCore, alpha, beta, maxIter, tol)
+}
+
+// HITS computes the hub and authority scores of each node in the graph for a Unit.
+// A good authority is pointed to by many good hubs, and a good hub points to many good authorities.
+// For undirected graphs both scores are equal.
+//
+// Parameters:
+//   - maxIter: The maximum number of iterations.
+//   - tol: The convergence threshold on the L1 difference between successive hub iterates.
+//
+// Returns:
+//   - A map of hub scores keyed by node identifier, summing to 1.
+//   - A map of authority scores keyed by node identifier, summing to 1.
+//   - An error if the iteration does not converge within maxIter iterations.
+func (u *Unit) HITS(maxIter int, tol float64) (map[graph.NodeID]float64, map[graph.NodeID]float64, error) {
+	return hits(u.snapshot(), 1, maxIter, tol)
+}
+
+// HITS computes the hub and authority scores of each node in the graph for a ParallelUnit.
+// Each iteration updates disjoint ranges of nodes in parallel.
+//
+// Parameters:
+//   - maxIter: The maximum number of iterations.
+//   - tol: The convergence threshold on the L1 difference between successive hub iterates.
+//
+// Returns:
+//   - A map of hub scores keyed by node identifier, summing to 1.
+//   - A map of authority scores keyed by node identifier, summing to 1.
+//   - An error if the iteration does not converge within maxIter iterations.
+func (pu *ParallelUnit) HITS(maxIter int, tol float64) (map[graph.NodeID]float64, map[graph.NodeID]float64, error) {
+	return hits(pu.snapshot(), pu.maxCore, maxIter, tol)
+}
+
+// katzCentrality runs the Katz iteration x = alpha * Aᵀx + beta over the adjacency view.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - workers: The number of goroutines used per iteration.
+//   - alpha, beta, maxIter, tol: See Unit.KatzCentrality.
+//
+// Returns:
+//   - The Katz centrality scores keyed by node identifier, or an error.
+func katzCentrality(adj *adjacency, workers uint, alpha, beta float64, maxIter int, tol float64) (map[graph.NodeID]float64, error) {
+	if math.IsNaN(alpha) || alpha <= 0 {
+		return nil, &InvalidParameterError{Parameter: "alpha", Reason: "must be positive"}
 	}
 
+	// Validate alpha against the spectral radius, above which the Katz series diverges.
+	radius, err := spectralRadius(adj, workers, maxIter, tol)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if radius > 0 && alpha >= 1/radius {
+		return nil, &InvalidParameterError{Parameter: "alpha", Reason: fmt.Sprintf("must be smaller than 1/λ = %g", 1/radius)}
+	}
+
+	n := adj.size()
+	centrality := make([]float64, n)
+
 	for iter := 0; iter < maxIter; iter++ {
 		newCentrality := make([]float64, n)
 
-		var wg sync.WaitGroup
+		// Update centrality scores from the incoming edges.
+		parallelRange(n, workers, func(lo, hi int) {
+			for i := lo; i < hi; i++ {
+				sum := 0.0
 
-		// Update centrality scores in parallel
-		for i := 0; i < n; i++ {
-			wg.Add(1)
-
-			go func(node int) {
-				defer wg.Done()
-				for j := 0; j < n; j++ {
-					if matrix[node][j] != graph.INF {
-						newCentrality[node] += float64(matrix[node][j].ToInt()) * centrality[j]
-					}
+				for k, j := range adj.in[i] {
+					sum += adj.inStrength[i][k] * centrality[j]
 				}
-			}(i)
+
+				newCentrality[i] = alpha*sum + beta
+			}
+		})
+
+		// Check for convergence
+		diff := 0.0
+		for i := 0; i < n; i++ {
+			diff += math.Abs(newCentrality[i] - centrality[i])
 		}
 
-		wg.Wait()
+		centrality = newCentrality
 
-		// Normalize the new centrality scores
-		norm := 0.0
-		for _, value := range newCentrality {
-			norm += value * value
+		if diff < tol {
+			return normalizedScores(adj, centrality, 2), nil
 		}
-		norm = math.Sqrt(norm)
+	}
 
-		for i := 0; i < n; i++ {
-			newCentrality[i] /= norm
+	return nil, &NotConvergedError{Algorithm: "katz centrality", MaxIter: maxIter}
+}
+
+// hits runs the HITS iteration over the adjacency view.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - workers: The number of goroutines used per iteration.
+//   - maxIter, tol: See Unit.HITS.
+//
+// Returns:
+//   - The hub and authority scores keyed by node identifier, or an error.
+func hits(adj *adjacency, workers uint, maxIter int, tol float64) (map[graph.NodeID]float64, map[graph.NodeID]float64, error) {
+	n := adj.size()
+	hubs := make([]float64, n)
+
+	for i := range hubs {
+		hubs[i] = 1 / float64(n)
+	}
+
+	if n == 0 {
+		return map[graph.NodeID]float64{}, map[graph.NodeID]float64{}, nil
+	}
+
+	for iter := 0; iter < maxIter; iter++ {
+		authorities := make([]float64, n)
+		newHubs := make([]float64, n)
+
+		// Authorities collect the hub scores of the nodes pointing to them.
+		parallelRange(n, workers, func(lo, hi int) {
+			for i := lo; i < hi; i++ {
+				for k, j := range adj.in[i] {
+					authorities[i] += adj.inStrength[i][k] * hubs[j]
+				}
+			}
+		})
+
+		// Hubs collect the authority scores of the nodes they point to.
+		parallelRange(n, workers, func(lo, hi int) {
+			for i := lo; i < hi; i++ {
+				for k, j := range adj.out[i] {
+					newHubs[i] += adj.strength[i][k] * authorities[j]
+				}
+			}
+		})
+
+		if !normalize(newHubs, 1) {
+			// Without any edges every score stays uniform.
+			return normalizedScores(adj, hubs, 1), normalizedScores(adj, hubs, 1), nil
 		}
 
 		// Check for convergence
 		diff := 0.0
 		for i := 0; i < n; i++ {
-			diff += math.Abs(newCentrality[i] - centrality[i])
+			diff += math.Abs(newHubs[i] - hubs[i])
 		}
 
+		hubs = newHubs
+
 		if diff < tol {
-			break
+			normalize(authorities, 1)
+			return normalizedScores(adj, hubs, 1), normalizedScores(adj, authorities, 1), nil
 		}
+	}
 
-		centrality = newCentrality
+	return nil, nil, &NotConvergedError{Algorithm: "hits", MaxIter: maxIter}
+}
+
+// spectralRadius estimates the largest eigenvalue modulus of the adjacency matrix by power iteration.
+// The iteration runs on A + I, which has the same dominant eigenvector but cannot oscillate on periodic graphs
+// such as directed cycles. The adjacency matrix of an acyclic graph is nilpotent, so its radius is 0;
+// power iteration would only approach it sublinearly, so such graphs are detected up front.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - workers: The number of goroutines used per iteration.
+//   - maxIter: The maximum number of iterations.
+//   - tol: The convergence threshold on the change of the estimate.
+//
+// Returns:
+//   - The estimated spectral radius, or an error if the estimate does not converge.
+func spectralRadius(adj *adjacency, workers uint, maxIter int, tol float64) (float64, error) {
+	n := adj.size()
+	vector := make([]float64, n)
+
+	for i := range vector {
+		vector[i] = 1
 	}
 
-	// Convert to map for output
+	if !normalize(vector, 1) || acyclic(adj) {
+		return 0, nil
+	}
+
+	estimate := 0.0
+
+	for iter := 0; iter < maxIter; iter++ {
+		next := make([]float64, n)
+
+		parallelRange(n, workers, func(lo, hi int) {
+			for i := lo; i < hi; i++ {
+				next[i] = vector[i]
+
+				for k, j := range adj.in[i] {
+					next[i] += adj.inStrength[i][k] * vector[j]
+				}
+			}
+		})
+
+		// The vector has unit L1 norm, so the norm of the product estimates the eigenvalue of A + I.
+		sum := 0.0
+		for _, value := range next {
+			sum += value
+		}
+
+		normalize(next, 1)
+		vector = next
+
+		if math.Abs(sum-1-estimate) < tol {
+			return sum - 1, nil
+		}
+
+		estimate = sum - 1
+	}
+
+	return 0, &NotConvergedError{Algorithm: "spectral radius", MaxIter: maxIter}
+}
+
+// normalize scales a non-negative vector in place to unit Lp norm for p = 1 or p = 2.
+//
+// Returns:
+//   - false if the vector is zero and cannot be normalized.
+func normalize(vector []float64, p int) bool {
+	norm := 0.0
+
+	for _, value := range vector {
+		if p == 2 {
+			norm += value * value
+		} else {
+			norm += math.Abs(value)
+		}
+	}
+
+	if p == 2 {
+		norm = math.Sqrt(norm)
+	}
+
+	if norm == 0 {
+		return false
+	}
+
+	for i := range vector {
+		vector[i] /= norm
+	}
+
+	return true
+}
+
+// normalizedScores converts a vector of scores into a map keyed by node identifier,
+// after normalizing it to unit Lp norm.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - scores: The scores indexed by row.
+//   - p: The norm used for normalization (1 or 2).
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the normalized scores.
+func normalizedScores(adj *adjacency, scores []float64, p int) map[graph.NodeID]float64 {
+	normalized := append([]float64(nil), scores...)
+	normalize(normalized, p)
+
 	result := make(map[graph.NodeID]float64)
-	for i := 0; i < n; i++ {
-		result[graph.NodeID(i)] = centrality[i]
+
+	for i, value := range normalized {
+		result[adj.id(i)] = value
 	}
 
 	return result
@@ -0,0 +1,155 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-netrics/internal/graph"
+)
+
+// ClosenessCentrality computes the closeness centrality of each node in the graph for a Unit.
+// Closeness centrality is the number of nodes that can reach a node divided by the sum of their
+// shortest path distances to it. For directed graphs, incoming distances are used.
+//
+// Parameters:
+//   - wfImproved: If true, the Wasserman–Faust correction is applied, scaling each score by the
+//     fraction of other nodes that can reach the node. This keeps scores comparable in disconnected graphs.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the closeness centrality scores.
+//     Nodes that no other node can reach score 0.
+//     A node whose incoming distances sum to zero or less, which negative weights allow, also scores 0.
+//
+// Notes:
+//   - Negative weights are handled with Johnson's algorithm. If the graph has a negative cycle,
+//     no shortest path exists and every score is 0; PathError reports the cycle.
+func (u *Unit) ClosenessCentrality(wfImproved bool) map[graph.NodeID]float64 {
+	return closenessCentrality(u.snapshot(), 1, wfImproved)
+}
+
+// ClosenessCentrality computes the closeness centrality of each node in the graph for a ParallelUnit.
+// The shortest path searches of different nodes are performed in parallel.
+//
+// Parameters:
+//   - wfImproved: If true, the Wasserman–Faust correction is applied, scaling each score by the
+//     fraction of other nodes that can reach the node. This keeps scores comparable in disconnected graphs.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the closeness centrality scores.
+//     Nodes that no other node can reach score 0.
+//     A node whose incoming distances sum to zero or less, which negative weights allow, also scores 0.
+//
+// Notes:
+//   - Negative weights are handled with Johnson's algorithm. If the graph has a negative cycle,
+//     no shortest path exists and every score is 0; PathError reports the cycle.
+func (pu *ParallelUnit) ClosenessCentrality(wfImproved bool) map[graph.NodeID]float64 {
+	return closenessCentrality(pu.snapshot(), pu.maxCore, wfImproved)
+}
+
+// HarmonicCentrality computes the harmonic centrality of each node in the graph for a Unit.
+// Harmonic centrality is the sum of the inverse shortest path distances from all other nodes,
+// where unreachable nodes contribute 0, so it is well defined for disconnected graphs.
+// For directed graphs, incoming distances are used.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the harmonic centrality scores,
+//     normalized by the maximum possible score (n-1). Only positive distances contribute.
+//
+// Notes:
+//   - Negative weights are handled with Johnson's algorithm. If the graph has a negative cycle,
+//     no shortest path exists and every score is 0; PathError reports the cycle.
+func (u *Unit) HarmonicCentrality() map[graph.NodeID]float64 {
+	return harmonicCentrality(u.snapshot(), 1)
+}
+
+// HarmonicCentrality computes the harmonic centrality of each node in the graph for a ParallelUnit.
+// The shortest path searches of different nodes are performed in parallel.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the harmonic centrality scores,
+//     normalized by the maximum possible score (n-1). Only positive distances contribute.
+//
+// Notes:
+//   - Negative weights are handled with Johnson's algorithm. If the graph has a negative cycle,
+//     no shortest path exists and every score is 0; PathError reports the cycle.
+func (pu *ParallelUnit) HarmonicCentrality() map[graph.NodeID]float64 {
+	return harmonicCentrality(pu.snapshot(), pu.maxCore)
+}
+
+// closenessCentrality computes the closeness centrality of every node from the distances of the reversed graph.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph, reweighted by pathView here if it has negative weights.
+//   - workers: The number of goroutines to use.
+//   - wfImproved: Whether the Wasserman–Faust correction is applied.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the closeness centrality scores.
+func closenessCentrality(adj *adjacency, workers uint, wfImproved bool) map[graph.NodeID]float64 {
+	view, err := pathView(adj)
+
+	if err != nil {
+		// A negative cycle leaves no shortest path to measure.
+		return nodeScores(adj, workers, func(int) float64 { return 0 })
+	}
+
+	adj = view.reversed()
+	n := adj.size()
+
+	return nodeScores(adj, workers, func(v int) float64 {
+		reach := 0
+		total := 0.0
+
+		for i, d := range sourceDistances(adj, v) {
+			if i != v && d != graph.INF {
+				reach++
+				total += float64(d)
+			}
+		}
+
+		if total <= 0 || n < 2 {
+			return 0
+		}
+
+		score := float64(reach) / total
+
+		if wfImproved {
+			score *= float64(reach) / float64(n-1)
+		}
+
+		return score
+	})
+}
+
+// harmonicCentrality computes the harmonic centrality of every node from the distances of the reversed graph.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph, reweighted by pathView here if it has negative weights.
+//   - workers: The number of goroutines to use.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the harmonic centrality scores.
+func harmonicCentrality(adj *adjacency, workers uint) map[graph.NodeID]float64 {
+	view, err := pathView(adj)
+
+	if err != nil {
+		// A negative cycle leaves no shortest path to measure.
+		return nodeScores(adj, workers, func(int) float64 { return 0 })
+	}
+
+	adj = view.reversed()
+	n := adj.size()
+
+	return nodeScores(adj, workers, func(v int) float64 {
+		if n < 2 {
+			return 0
+		}
+
+		total := 0.0
+
+		for i, d := range sourceDistances(adj, v) {
+			if i != v && d != graph.INF && d > 0 {
+				total += 1 / float64(d)
+			}
+		}
+
+		return total / float64(n-1)
+	})
+}
@@ -0,0 +1,26 @@
+package algorithm
+
+// Collapse is an enumeration that defines how the parallel edges of a multigraph are combined into one edge
+// by degree, random-walk and spectral measures. Path-based measures always follow the lightest parallel edge.
+type Collapse int
+
+// Enumeration values for Collapse.
+const (
+	COLLAPSE_MIN   Collapse = iota // Parallel edges count as one edge with the smallest weight.
+	COLLAPSE_COUNT                 // Parallel edges count separately; the combined edge weighs the number of edges.
+	COLLAPSE_SUM                   // The combined edge weighs the sum of the weights of the parallel edges.
+)
+
+// String converts a Collapse value to its string representation.
+func (c Collapse) String() string {
+	switch c {
+	case COLLAPSE_MIN:
+		return "Minimum Collapse"
+	case COLLAPSE_COUNT:
+		return "Count Collapse"
+	case COLLAPSE_SUM:
+		return "Sum Collapse"
+	default:
+		return "Unknown Collapse"
+	}
+}
@@ -1,6 +1,7 @@
 package algorithm
 
 import (
+	"container/heap"
 	"sort"
 	"sync"
 
@@ -8,58 +9,54 @@ import (
 )
 
 // computePaths calculates all shortest paths between every pair of nodes in the graph for a Unit.
+// One single-source traversal is run per source node instead of one search per pair.
+// Graphs with negative weights are reweighted with Johnson's algorithm first.
 // After computation, the `shortestPaths` field in the Unit is updated and sorted by path distance in ascending order.
-//
-// Parameters:
-//   - g: The graph to perform the computation on.
+// If the graph has a negative cycle, no paths are stored and the error is kept in `pathErr`.
 func (u *Unit) computePaths() {
 	g := u.graph
-	u.shortestPaths = []graph.Path{}
-	n := len(g.Matrix())
+	view := u.snapshot()
+	adj, err := pathView(view)
 
-	for start := graph.NodeID(0); start < graph.NodeID(n); start++ {
-		for end := graph.NodeID(0); end < graph.NodeID(n); end++ {
-			if start == end {
-				continue
-			}
-
-			path := shortestPath(g, start, end)
+	u.shortestPaths = []graph.Path{}
+	u.pathErr = err
 
-			if path.Distance() != graph.INF {
-				u.shortestPaths = append(u.shortestPaths, *path)
-			}
+	if err == nil {
+		for start := 0; start < adj.size(); start++ {
+			u.shortestPaths = append(u.shortestPaths, sourcePaths(adj, start)...)
 		}
 	}
 
-	// Sort the paths by their total distance.
-	sort.Slice(u.shortestPaths, func(i, j int) bool {
-		return u.shortestPaths[i].Distance() < u.shortestPaths[j].Distance()
-	})
+	u.indexPaths()
 
+	u.version = view.version
 	u.updated = true
 	g.Update()
 }
 
 // computePaths calculates all shortest paths in parallel for a ParallelUnit.
+// Source nodes are distributed across the workers, and each worker runs one single-source traversal per source.
+// Graphs with negative weights are reweighted with Johnson's algorithm first.
 // After computation, the `shortestPaths` field in the ParallelUnit is updated and sorted by path distance in ascending order.
-//
-// Parameters:
-//   - g: The graph to perform the computation on.
+// If the graph has a negative cycle, no paths are stored and the error is kept in `pathErr`.
 func (pu *ParallelUnit) computePaths() {
 	g := pu.graph
-	pu.shortestPaths = []graph.Path{}
+	view := pu.snapshot()
+	adj, err := pathView(view)
+	n := 0
 
-	type to struct {
-		start graph.NodeID
-		end   graph.NodeID
+	if err == nil {
+		n = adj.size()
 	}
 
-	n := len(g.Matrix())
-
-	jobChan := make(chan to)
-	resultChan := make(chan graph.Path)
+	jobChan := make(chan int)
+	results := make([][]graph.Path, n) // Paths grouped by source, so the result does not depend on scheduling.
 	workerCount := pu.maxCore
 
+	if workerCount == 0 {
+		workerCount = 1
+	}
+
 	var wg sync.WaitGroup
 	wg.Add(int(workerCount))
 
@@ -67,157 +64,174 @@ func (pu *ParallelUnit) computePaths() {
 	for i := uint(0); i < workerCount; i++ {
 		go func() {
 			defer wg.Done()
-			for job := range jobChan {
-				path := shortestPath(g, job.start, job.end)
-
-				if path.Distance() != graph.INF {
-					resultChan <- *path
-				}
+			for start := range jobChan {
+				results[start] = sourcePaths(adj, start)
 			}
 		}()
 	}
 
-	// Generate jobs for every pair of nodes.
-	go func() {
-		for start := 0; start < n; start++ {
-			for end := 0; end < n; end++ {
-				if start != end {
-					jobChan <- to{graph.NodeID(start), graph.NodeID(end)}
-				}
-			}
-		}
-		close(jobChan)
-	}()
-
-	// Close the result channel after all workers finish.
-	go func() {
-		wg.Wait()
-		close(resultChan)
-	}()
-
-	// Collect results from workers.
-	for result := range resultChan {
-		pu.shortestPaths = append(pu.shortestPaths, result)
+	// Generate one job for every live source node.
+	for start := 0; start < n; start++ {
+		jobChan <- start
 	}
+	close(jobChan)
 
-	// Sort the paths by their total distance.
-	sort.Slice(pu.shortestPaths, func(i, j int) bool {
-		return pu.shortestPaths[i].Distance() < pu.shortestPaths[j].Distance()
-	})
+	wg.Wait()
+
+	pu.shortestPaths = []graph.Path{}
+	pu.pathErr = err
+
+	for _, paths := range results {
+		pu.shortestPaths = append(pu.shortestPaths, paths...)
+	}
+
+	pu.indexPaths()
 
+	pu.version = view.version
 	pu.updated = true
 	g.Update()
 }
 
-// shortestPath computes the shortest path between two nodes in a graph.
+// indexPaths sorts the computed shortest paths by their total distance
+// and records the position of every path by its endpoints.
+func (u *Unit) indexPaths() {
+	// Sort the paths by their total distance; equal distances keep the source/target order.
+	sort.SliceStable(u.shortestPaths, func(i, j int) bool {
+		return u.shortestPaths[i].Distance() < u.shortestPaths[j].Distance()
+	})
+
+	u.pathIndex = make(map[pathKey]int, len(u.shortestPaths))
+
+	for i, p := range u.shortestPaths {
+		nodes := p.Nodes()
+		u.pathIndex[pathKey{nodes[0], nodes[len(nodes)-1]}] = i
+	}
+}
+
+// findPath looks up the stored shortest path between two nodes.
 //
 // Parameters:
-//   - g: The graph to perform the computation on.
-//   - start: The starting node identifier.
-//   - end: The ending node identifier.
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
 //
 // Returns:
-//   - A graph.Path containing the shortest path and its total distance.
-//   - If no path exists, the returned Path has distance INF and an empty node sequence.
-func shortestPath(g *graph.Graph, start, end graph.NodeID) *graph.Path {
-	if g.Type() == graph.DIRECTED_WEIGHTED || g.Type() == graph.UNDIRECTED_WEIGHTED {
-		return weightedShortestPath(g.Matrix(), start, end)
-	} else if g.Type() == graph.DIRECTED_UNWEIGHTED || g.Type() == graph.UNDIRECTED_UNWEIGHTED {
-		return unweightedShortestPath(g.Matrix(), start, end)
-	} else {
-		return graph.NewPath(graph.INF, []graph.NodeID{})
+//   - The stored path and true if `to` is reachable from `from`, or an empty path and false otherwise.
+func (u *Unit) findPath(from, to graph.NodeID) (graph.Path, bool) {
+	if i, ok := u.pathIndex[pathKey{from, to}]; ok {
+		return u.shortestPaths[i], true
 	}
+
+	return graph.Path{}, false
 }
 
-// weightedShortestPath computes the shortest path between two nodes in a weighted graph.
-// Uses Dijkstra's algorithm to calculate the path.
+// sourcePaths computes the shortest paths from one source node to every node reachable from it.
 //
 // Parameters:
-//   - matrix: The adjacency matrix representation of the graph.
-//   - start: The starting node identifier.
-//   - end: The ending node identifier.
+//   - adj: The adjacency view of the graph.
+//   - start: The row of the source node.
 //
 // Returns:
-//   - A graph.Path containing the shortest path and its total distance.
-func weightedShortestPath(matrix graph.Matrix, start, end graph.NodeID) *graph.Path {
-	n := len(matrix)
+//   - A slice of paths from `start` to each reachable node other than `start`, ordered by target row.
+func sourcePaths(adj *adjacency, start int) []graph.Path {
+	dist, prev := shortest(adj, start)
+	paths := []graph.Path{}
+
+	for end := range dist {
+		if end == start || dist[end] == graph.INF {
+			continue
+		}
 
-	if int(start) >= n || int(end) >= n {
-		return graph.NewPath(graph.INF, []graph.NodeID{})
+		paths = append(paths, *graph.NewPath(dist[end], tracePath(adj, prev, end)))
 	}
 
-	dist := make([]graph.Distance, n)
-	prev := make([]int, n)
-	visited := make([]bool, n)
+	return paths
+}
 
-	for i := range dist {
-		dist[i] = graph.INF
-		prev[i] = -1
-	}
+// sourceDistances computes the shortest path distances from one source node to every node.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - start: The row of the source node.
+//
+// Returns:
+//   - The distance of every node from `start`, or INF if it is unreachable.
+func sourceDistances(adj *adjacency, start int) []graph.Distance {
+	dist, _ := shortest(adj, start)
+	return dist
+}
 
-	dist[start] = 0
+// shortest runs the single-source search that suits the view: breadth-first search if it is unweighted,
+// Dijkstra's algorithm if its weights are non-negative, and Bellman-Ford otherwise.
+// Distances found on a view reweighted by johnson are shifted back to the original weights.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - start: The row of the source node.
+//
+// Returns:
+//   - The distance of every node from `start`, or INF if it is unreachable.
+//   - The predecessor of every node on its shortest path, or -1 for the source and unreachable nodes.
+func shortest(adj *adjacency, start int) ([]graph.Distance, []int) {
+	if !adj.weighted {
+		return bfs(adj, start)
+	}
 
-	for {
-		minDist := graph.INF
-		u := -1
-		for i := 0; i < n; i++ {
-			if !visited[i] && dist[i] < minDist {
-				minDist = dist[i]
-				u = i
-			}
-		}
+	if adj.negative {
+		// The distances are meaningless if a negative cycle is reachable, but the search still ends.
+		dist, prev := unreached(adj.size())
+		dist[start] = 0
+		bellmanFord(adj, dist, prev)
 
-		if u == -1 {
-			break
-		}
+		return dist, prev
+	}
 
-		visited[u] = true
+	dist, prev := dijkstra(adj, start)
 
-		for v := 0; v < n; v++ {
-			if matrix[u][v] > 0 && !visited[v] {
-				alt := dist[u] + matrix[u][v]
-				if alt < dist[v] {
-					dist[v] = alt
-					prev[v] = u
-				}
+	if adj.potential != nil {
+		for i := range dist {
+			if dist[i] != graph.INF {
+				dist[i] += adj.potential[i] - adj.potential[start]
 			}
 		}
 	}
 
+	return dist, prev
+}
+
+// tracePath rebuilds the node sequence ending at `end` by following predecessor links back to the source.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - prev: The predecessor of each node on its shortest path, or -1 for the source and unreachable nodes.
+//   - end: The row of the destination node.
+//
+// Returns:
+//   - The node sequence from the source to `end`.
+func tracePath(adj *adjacency, prev []int, end int) []graph.NodeID {
 	path := []graph.NodeID{}
 
-	for at := int(end); at != -1; at = prev[at] {
-		path = append(path, graph.NodeID(at))
+	for at := end; at != -1; at = prev[at] {
+		path = append(path, adj.id(at))
 	}
 
 	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
 		path[i], path[j] = path[j], path[i]
 	}
 
-	if dist[end] == graph.INF {
-		return graph.NewPath(graph.INF, []graph.NodeID{})
-	}
-
-	return graph.NewPath(dist[end], path)
+	return path
 }
 
-// unweightedShortestPath computes the shortest path between two nodes in an unweighted graph.
-// Uses BFS to calculate the path.
+// bfs computes single-source shortest path distances in an unweighted graph using breadth-first search.
 //
 // Parameters:
-//   - matrix: The adjacency matrix representation of the graph.
-//   - start: The starting node identifier.
-//   - end: The ending node identifier.
+//   - adj: The adjacency view of the graph.
+//   - start: The row of the source node.
 //
 // Returns:
-//   - A graph.Path containing the shortest path and its total distance.
-func unweightedShortestPath(matrix graph.Matrix, start, end graph.NodeID) *graph.Path {
-	n := len(matrix)
-
-	if int(start) >= n || int(end) >= n {
-		return graph.NewPath(graph.INF, []graph.NodeID{})
-	}
-
+//   - The distance of every node from `start`, or INF if it is unreachable.
+//   - The predecessor of every node on its shortest path, or -1 for the source and unreachable nodes.
+func bfs(adj *adjacency, start int) ([]graph.Distance, []int) {
+	n := adj.size()
 	dist := make([]graph.Distance, n)
 	prev := make([]int, n)
 
@@ -226,15 +240,15 @@ func unweightedShortestPath(matrix graph.Matrix, start, end graph.NodeID) *graph
 		prev[i] = -1
 	}
 
-	queue := []int{int(start)}
+	queue := make([]int, 0, n)
+	queue = append(queue, start)
 	dist[start] = 0
 
-	for len(queue) > 0 {
-		u := queue[0]
-		queue = queue[1:]
+	for head := 0; head < len(queue); head++ {
+		u := queue[head]
 
-		for v := 0; v < n; v++ {
-			if matrix[u][v] == 1 && dist[v] == graph.INF {
+		for _, v := range adj.out[u] {
+			if dist[v] == graph.INF {
 				dist[v] = dist[u] + 1
 				prev[v] = u
 				queue = append(queue, v)
@@ -242,19 +256,90 @@ func unweightedShortestPath(matrix graph.Matrix, start, end graph.NodeID) *graph
 		}
 	}
 
-	path := []graph.NodeID{}
+	return dist, prev
+}
 
-	for at := int(end); at != -1; at = prev[at] {
-		path = append(path, graph.NodeID(at))
+// dijkstra computes single-source shortest path distances in a weighted graph
+// using Dijkstra's algorithm with a binary heap.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - start: The row of the source node.
+//
+// Returns:
+//   - The distance of every node from `start`, or INF if it is unreachable.
+//   - The predecessor of every node on its shortest path, or -1 for the source and unreachable nodes.
+func dijkstra(adj *adjacency, start int) ([]graph.Distance, []int) {
+	n := adj.size()
+	dist := make([]graph.Distance, n)
+	prev := make([]int, n)
+	visited := make([]bool, n)
+
+	for i := range dist {
+		dist[i] = graph.INF
+		prev[i] = -1
 	}
 
-	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
-		path[i], path[j] = path[j], path[i]
+	dist[start] = 0
+	queue := &distanceHeap{{node: start, distance: 0}}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(heapItem)
+		u := item.node
+
+		// Skip stale entries left behind by later improvements.
+		if visited[u] {
+			continue
+		}
+
+		visited[u] = true
+
+		for k, v := range adj.out[u] {
+			if visited[v] {
+				continue
+			}
+
+			alt := dist[u] + adj.weight[u][k]
+
+			if alt < dist[v] {
+				dist[v] = alt
+				prev[v] = u
+				heap.Push(queue, heapItem{node: v, distance: alt})
+			}
+		}
 	}
 
-	if dist[end] == graph.INF {
-		return graph.NewPath(graph.INF, []graph.NodeID{})
+	return dist, prev
+}
+
+// heapItem is an entry of the Dijkstra priority queue.
+type heapItem struct {
+	node     int            // The row of the node.
+	distance graph.Distance // The tentative distance of the node when it was pushed.
+}
+
+// distanceHeap is a min-heap of heapItems ordered by distance, implementing heap.Interface.
+// Ties are broken by row so that the traversal order is deterministic.
+type distanceHeap []heapItem
+
+func (h distanceHeap) Len() int { return len(h) }
+
+func (h distanceHeap) Less(i, j int) bool {
+	if h[i].distance != h[j].distance {
+		return h[i].distance < h[j].distance
 	}
 
-	return graph.NewPath(dist[end], path)
+	return h[i].node < h[j].node
+}
+
+func (h distanceHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *distanceHeap) Push(x any) { *h = append(*h, x.(heapItem)) }
+
+func (h *distanceHeap) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return item
 }
@@ -1 +1,436 @@
 package algorithm
+
+import (
+	"github.com/elecbug/go-netrics/internal/graph"
+)
+
+// WeaklyConnectedComponents assigns every node of the graph to a weakly connected component for a Unit.
+// Edge directions are ignored, so for undirected graphs these are the ordinary connected components.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are component labels.
+//     Labels start at 0 and are ordered by the smallest node identifier of each component.
+func (u *Unit) WeaklyConnectedComponents() map[graph.NodeID]int {
+	adj := u.snapshot()
+	return componentMap(adj, weakComponents(adj))
+}
+
+// WeaklyConnectedComponents assigns every node of the graph to a weakly connected component for a ParallelUnit.
+// The traversal itself is sequential; the method is provided for API symmetry with Unit.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are component labels.
+//     Labels start at 0 and are ordered by the smallest node identifier of each component.
+func (pu *ParallelUnit) WeaklyConnectedComponents() map[graph.NodeID]int {
+	adj := pu.snapshot()
+	return componentMap(adj, weakComponents(adj))
+}
+
+// StronglyConnectedComponents assigns every node of the graph to a strongly connected component for a Unit.
+// Components are found with Tarjan's algorithm. For undirected graphs the result equals WeaklyConnectedComponents.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are component labels.
+//     Labels start at 0 and are ordered by the smallest node identifier of each component.
+func (u *Unit) StronglyConnectedComponents() map[graph.NodeID]int {
+	adj := u.snapshot()
+	return componentMap(adj, strongComponents(adj))
+}
+
+// StronglyConnectedComponents assigns every node of the graph to a strongly connected component for a ParallelUnit.
+// Tarjan's algorithm is inherently sequential; the method is provided for API symmetry with Unit.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are component labels.
+//     Labels start at 0 and are ordered by the smallest node identifier of each component.
+func (pu *ParallelUnit) StronglyConnectedComponents() map[graph.NodeID]int {
+	adj := pu.snapshot()
+	return componentMap(adj, strongComponents(adj))
+}
+
+// ComponentCount returns the number of connected components in the graph for a Unit.
+//
+// Parameters:
+//   - strong: If true, strongly connected components are counted; otherwise weakly connected components.
+//
+// Returns:
+//   - The number of components. An empty graph has no components.
+func (u *Unit) ComponentCount(strong bool) int {
+	_, labels := u.components(strong)
+	return countComponents(labels)
+}
+
+// ComponentCount returns the number of connected components in the graph for a ParallelUnit.
+//
+// Parameters:
+//   - strong: If true, strongly connected components are counted; otherwise weakly connected components.
+//
+// Returns:
+//   - The number of components. An empty graph has no components.
+func (pu *ParallelUnit) ComponentCount(strong bool) int {
+	_, labels := pu.components(strong)
+	return countComponents(labels)
+}
+
+// LargestComponent returns the nodes of the largest connected component for a Unit.
+//
+// Parameters:
+//   - strong: If true, strongly connected components are considered; otherwise weakly connected components.
+//
+// Returns:
+//   - The identifiers of the nodes in the largest component, in ascending order.
+//     Ties are broken in favour of the component containing the smallest identifier.
+//     An empty graph returns an empty slice.
+func (u *Unit) LargestComponent(strong bool) []graph.NodeID {
+	return largestComponent(u.components(strong))
+}
+
+// LargestComponent returns the nodes of the largest connected component for a ParallelUnit.
+//
+// Parameters:
+//   - strong: If true, strongly connected components are considered; otherwise weakly connected components.
+//
+// Returns:
+//   - The identifiers of the nodes in the largest component, in ascending order.
+//     Ties are broken in favour of the component containing the smallest identifier.
+//     An empty graph returns an empty slice.
+func (pu *ParallelUnit) LargestComponent(strong bool) []graph.NodeID {
+	return largestComponent(pu.components(strong))
+}
+
+// IsConnected reports whether the graph consists of a single connected component for a Unit.
+//
+// Parameters:
+//   - strong: If true, strong connectivity is required; otherwise weak connectivity is enough.
+//
+// Returns:
+//   - true if the graph has exactly one component. An empty graph is not connected.
+func (u *Unit) IsConnected(strong bool) bool {
+	return u.ComponentCount(strong) == 1
+}
+
+// IsConnected reports whether the graph consists of a single connected component for a ParallelUnit.
+//
+// Parameters:
+//   - strong: If true, strong connectivity is required; otherwise weak connectivity is enough.
+//
+// Returns:
+//   - true if the graph has exactly one component. An empty graph is not connected.
+func (pu *ParallelUnit) IsConnected(strong bool) bool {
+	return pu.ComponentCount(strong) == 1
+}
+
+// components computes the component label of every row of the graph.
+//
+// Parameters:
+//   - strong: If true, strongly connected components are computed; otherwise weakly connected components.
+//
+// Returns:
+//   - The adjacency view the labels refer to.
+//   - A slice of labels indexed by row.
+func (u *Unit) components(strong bool) (*adjacency, []int) {
+	adj := u.snapshot()
+
+	if strong {
+		return adj, strongComponents(adj)
+	}
+
+	return adj, weakComponents(adj)
+}
+
+// weakComponents labels the weakly connected components of the graph with a breadth-first search
+// that follows both outgoing and incoming edges.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//
+// Returns:
+//   - A slice of labels indexed by row.
+func weakComponents(adj *adjacency) []int {
+	n := adj.size()
+	labels := make([]int, n)
+
+	for i := range labels {
+		labels[i] = -1
+	}
+
+	next := 0
+
+	for start := 0; start < n; start++ {
+		if labels[start] != -1 {
+			continue
+		}
+
+		labels[start] = next
+		queue := []int{start}
+
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+
+			for _, rows := range [][]int{adj.out[v], adj.in[v]} {
+				for _, w := range rows {
+					if labels[w] == -1 {
+						labels[w] = next
+						queue = append(queue, w)
+					}
+				}
+			}
+		}
+
+		next++
+	}
+
+	return labels
+}
+
+// strongComponents labels the strongly connected components of the graph using an iterative
+// version of Tarjan's algorithm, so deep graphs do not exhaust the goroutine stack.
+// Nodes that cannot lie on a cycle are trimmed first using the in- and out-degrees.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//
+// Returns:
+//   - A slice of labels indexed by row.
+//     Labels are ordered by the smallest node identifier of each component.
+func strongComponents(adj *adjacency) []int {
+	n := adj.size()
+	index := make([]int, n)   // Discovery order of each node, or -1 if not yet visited.
+	lowLink := make([]int, n) // Smallest discovery order reachable from the node's subtree.
+	onStack := make([]bool, n)
+	labels := make([]int, n)
+
+	for i := 0; i < n; i++ {
+		index[i] = -1
+		labels[i] = -1
+	}
+
+	type frame struct {
+		node int // The node being expanded.
+		edge int // The position of the next outgoing edge to examine.
+	}
+
+	stack := []int{}
+	counter := 0
+	found := 0
+
+	// A node without incoming or outgoing edges among the remaining nodes is a component on its own.
+	// Trimming such nodes repeatedly, with degrees read from the incoming-edge index,
+	// leaves Tarjan's algorithm only the nodes that may lie on a cycle.
+	inDegree := make([]int, n)
+	outDegree := make([]int, n)
+	trimmed := []int{}
+
+	trim := func(v int) {
+		index[v] = counter
+		counter++
+		labels[v] = found
+		found++
+		trimmed = append(trimmed, v)
+	}
+
+	for v := 0; v < n; v++ {
+		inDegree[v], outDegree[v] = len(adj.in[v]), len(adj.out[v])
+
+		if inDegree[v] == 0 || outDegree[v] == 0 {
+			trim(v)
+		}
+	}
+
+	for head := 0; head < len(trimmed); head++ {
+		v := trimmed[head]
+
+		for _, w := range adj.out[v] {
+			if inDegree[w]--; inDegree[w] == 0 && index[w] == -1 {
+				trim(w)
+			}
+		}
+
+		for _, w := range adj.in[v] {
+			if outDegree[w]--; outDegree[w] == 0 && index[w] == -1 {
+				trim(w)
+			}
+		}
+	}
+
+	for root := 0; root < n; root++ {
+		if index[root] != -1 {
+			continue
+		}
+
+		calls := []frame{{node: root}}
+		index[root], lowLink[root] = counter, counter
+		counter++
+		stack = append(stack, root)
+		onStack[root] = true
+
+		for len(calls) > 0 {
+			top := &calls[len(calls)-1]
+			v := top.node
+
+			if top.edge < len(adj.out[v]) {
+				w := adj.out[v][top.edge]
+				top.edge++
+
+				if index[w] == -1 {
+					// Descend into an unvisited successor.
+					index[w], lowLink[w] = counter, counter
+					counter++
+					stack = append(stack, w)
+					onStack[w] = true
+					calls = append(calls, frame{node: w})
+				} else if onStack[w] && index[w] < lowLink[v] {
+					lowLink[v] = index[w]
+				}
+
+				continue
+			}
+
+			// All successors are done; pop the frame and propagate the low-link to the parent.
+			calls = calls[:len(calls)-1]
+
+			if len(calls) > 0 {
+				parent := calls[len(calls)-1].node
+
+				if lowLink[v] < lowLink[parent] {
+					lowLink[parent] = lowLink[v]
+				}
+			}
+
+			if lowLink[v] == index[v] {
+				// v is the root of a component; pop its members from the stack.
+				for {
+					w := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[w] = false
+					labels[w] = found
+
+					if w == v {
+						break
+					}
+				}
+
+				found++
+			}
+		}
+	}
+
+	return relabel(labels, found)
+}
+
+// acyclic reports whether the graph has no cycle: every strongly connected component is a single node
+// and no node has a self-loop.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//
+// Returns:
+//   - true if the graph is acyclic.
+func acyclic(adj *adjacency) bool {
+	if countComponents(strongComponents(adj)) != adj.size() {
+		return false
+	}
+
+	for v := 0; v < adj.size(); v++ {
+		if adj.hasEdge(v, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// relabel renumbers component labels so that they are ordered by the smallest node identifier of each component.
+//
+// Parameters:
+//   - labels: The labels indexed by row.
+//   - count: The number of distinct labels.
+//
+// Returns:
+//   - The relabelled slice.
+func relabel(labels []int, count int) []int {
+	order := make([]int, count)
+
+	for i := range order {
+		order[i] = -1
+	}
+
+	next := 0
+
+	for i, label := range labels {
+		if order[label] == -1 {
+			order[label] = next
+			next++
+		}
+
+		labels[i] = order[label]
+	}
+
+	return labels
+}
+
+// componentMap converts a slice of component labels into a map keyed by node identifier.
+//
+// Parameters:
+//   - adj: The adjacency view the labels refer to.
+//   - labels: The labels indexed by row.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are component labels.
+func componentMap(adj *adjacency, labels []int) map[graph.NodeID]int {
+	result := make(map[graph.NodeID]int)
+
+	for i, label := range labels {
+		result[adj.id(i)] = label
+	}
+
+	return result
+}
+
+// countComponents returns the number of distinct labels in a slice of component labels.
+func countComponents(labels []int) int {
+	count := 0
+
+	for _, label := range labels {
+		if label+1 > count {
+			count = label + 1
+		}
+	}
+
+	return count
+}
+
+// largestComponent returns the members of the component with the most nodes.
+//
+// Parameters:
+//   - adj: The adjacency view the labels refer to.
+//   - labels: The labels indexed by row.
+//
+// Returns:
+//   - The identifiers of the nodes in the largest component, in ascending order.
+func largestComponent(adj *adjacency, labels []int) []graph.NodeID {
+	sizes := make([]int, countComponents(labels))
+
+	for _, label := range labels {
+		sizes[label]++
+	}
+
+	best := -1
+
+	for label, size := range sizes {
+		if best == -1 || size > sizes[best] {
+			best = label
+		}
+	}
+
+	result := []graph.NodeID{}
+
+	for i, label := range labels {
+		if label == best {
+			result = append(result, adj.id(i))
+		}
+	}
+
+	return result
+}
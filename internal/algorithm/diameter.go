@@ -8,18 +8,22 @@ import (
 // The diameter is defined as the longest shortest path between any two nodes in the graph.
 //
 // Returns:
-//   - A graph.Path representing the longest shortest path in the graph.
+//   - A graph.Path representing the longest shortest path in the graph, or a path of distance INF
+//     without nodes if there is none, as when the graph has a negative cycle (see PathError).
 //
 // Notes:
 //   - If the graph or the Unit has been updated, shortest paths are recomputed.
 func (u *Unit) Diameter() graph.Path {
-	g := u.graph
-
-	if !g.IsUpdated() || !u.updated {
+	if !u.isCurrent() {
 		// Recompute shortest paths if the graph or unit has been updated.
 		u.computePaths()
 	}
 
+	// No paths are stored when the graph is empty or has a negative cycle.
+	if len(u.shortestPaths) == 0 {
+		return *graph.NewPath(graph.INF, nil)
+	}
+
 	// The diameter corresponds to the last (longest) path in the sorted shortestPaths slice.
 	return u.shortestPaths[len(u.shortestPaths)-1]
 }
@@ -27,18 +31,22 @@ func (u *Unit) Diameter() graph.Path {
 // Diameter computes the diameter of the graph for a ParallelUnit.
 //
 // Returns:
-//   - A graph.Path representing the longest shortest path in the graph.
+//   - A graph.Path representing the longest shortest path in the graph, or a path of distance INF
+//     without nodes if there is none, as when the graph has a negative cycle (see PathError).
 //
 // Notes:
 //   - If the graph or the ParallelUnit has been updated, shortest paths are recomputed in parallel.
 func (pu *ParallelUnit) Diameter() graph.Path {
-	g := pu.graph
-
-	if !g.IsUpdated() || !pu.updated {
+	if !pu.isCurrent() {
 		// Recompute shortest paths if the graph or unit has been updated.
 		pu.computePaths()
 	}
 
+	// No paths are stored when the graph is empty or has a negative cycle.
+	if len(pu.shortestPaths) == 0 {
+		return *graph.NewPath(graph.INF, nil)
+	}
+
 	// The diameter corresponds to the last (longest) path in the sorted shortestPaths slice.
 	return pu.shortestPaths[len(pu.shortestPaths)-1]
 }
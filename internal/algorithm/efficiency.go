@@ -12,9 +12,7 @@ import (
 // Returns:
 //   - The global efficiency as a float64.
 func (u *Unit) GlobalEfficiency() float64 {
-	g := u.graph
-
-	if !g.IsUpdated() || !u.updated {
+	if !u.isCurrent() {
 		// Recompute shortest paths if the graph or unit has been updated.
 		u.computePaths()
 	}
@@ -43,9 +41,7 @@ func (u *Unit) GlobalEfficiency() float64 {
 // Returns:
 //   - The global efficiency as a float64.
 func (pu *ParallelUnit) GlobalEfficiency() float64 {
-	g := pu.graph
-
-	if !g.IsUpdated() || !pu.updated {
+	if !pu.isCurrent() {
 		// Recompute shortest paths if the graph or unit has been updated.
 		pu.computePaths()
 	}
@@ -74,9 +70,7 @@ func (pu *ParallelUnit) GlobalEfficiency() float64 {
 // Returns:
 //   - A map where the keys are node identifiers and the values are the local efficiency scores.
 func (u *Unit) LocalEfficiency() map[graph.NodeID]float64 {
-	g := u.graph
-
-	if !g.IsUpdated() || !u.updated {
+	if !u.isCurrent() {
 		// Recompute shortest paths if the graph or unit has been updated.
 		u.computePaths()
 	}
@@ -119,11 +113,9 @@ func (u *Unit) LocalEfficiency() map[graph.NodeID]float64 {
 		totalEfficiency := 0.0
 		for i := 0; i < k; i++ {
 			for j := i + 1; j < k; j++ {
-				for _, path := range u.shortestPaths {
-					if len(path.Nodes()) > 1 && path.Nodes()[0] == neighborList[i] && path.Nodes()[len(path.Nodes())-1] == neighborList[j] {
-						if path.Distance() != graph.INF && path.Distance() > 0 {
-							totalEfficiency += 1.0 / float64(path.Distance())
-						}
+				if path, ok := u.findPath(neighborList[i], neighborList[j]); ok {
+					if path.Distance() != graph.INF && path.Distance() > 0 {
+						totalEfficiency += 1.0 / float64(path.Distance())
 					}
 				}
 			}
@@ -142,9 +134,7 @@ func (u *Unit) LocalEfficiency() map[graph.NodeID]float64 {
 // Returns:
 //   - A map where the keys are node identifiers and the values are the local efficiency scores.
 func (pu *ParallelUnit) LocalEfficiency() map[graph.NodeID]float64 {
-	g := pu.graph
-
-	if !g.IsUpdated() || !pu.updated {
+	if !pu.isCurrent() {
 		// Recompute shortest paths if the graph or unit has been updated.
 		pu.computePaths()
 	}
@@ -195,11 +185,9 @@ func (pu *ParallelUnit) LocalEfficiency() map[graph.NodeID]float64 {
 			totalEfficiency := 0.0
 			for i := 0; i < k; i++ {
 				for j := i + 1; j < k; j++ {
-					for _, path := range pu.shortestPaths {
-						if len(path.Nodes()) > 1 && path.Nodes()[0] == neighborList[i] && path.Nodes()[len(path.Nodes())-1] == neighborList[j] {
-							if path.Distance() != graph.INF && path.Distance() > 0 {
-								totalEfficiency += 1.0 / float64(path.Distance())
-							}
+					if path, ok := pu.findPath(neighborList[i], neighborList[j]); ok {
+						if path.Distance() != graph.INF && path.Distance() > 0 {
+							totalEfficiency += 1.0 / float64(path.Distance())
 						}
 					}
 				}
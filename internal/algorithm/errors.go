@@ -0,0 +1,52 @@
+package algorithm
+
+import (
+	"fmt"
+
+	"github.com/elecbug/go-netrics/internal/algorithm/internal/algorithm_err" // Custom error package
+	"github.com/elecbug/go-netrics/internal/graph"
+)
+
+// Sentinel errors returned by algorithms, wrapped in the structured error types below.
+// Use errors.Is to test for a kind of error and errors.As to retrieve its details.
+var (
+	ErrNotConverged     = algorithm_err.ErrNotConverged     // An iterative algorithm did not converge.
+	ErrInvalidParameter = algorithm_err.ErrInvalidParameter // A parameter is outside its valid range.
+	ErrNegativeCycle    = algorithm_err.ErrNegativeCycle    // A cycle of negative total weight makes shortest paths undefined.
+)
+
+// NotConvergedError reports an iterative algorithm that did not converge. It wraps ErrNotConverged.
+type NotConvergedError struct {
+	Algorithm string // The name of the algorithm.
+	MaxIter   int    // The number of iterations that were run.
+}
+
+func (e *NotConvergedError) Error() string {
+	return algorithm_err.NotConverged(e.Algorithm, e.MaxIter).Error()
+}
+
+func (e *NotConvergedError) Unwrap() error { return ErrNotConverged }
+
+// InvalidParameterError reports a parameter outside its valid range. It wraps ErrInvalidParameter.
+type InvalidParameterError struct {
+	Parameter string // The name of the parameter.
+	Reason    string // Why the value was rejected.
+}
+
+func (e *InvalidParameterError) Error() string {
+	return algorithm_err.InvalidParameter(e.Parameter, e.Reason).Error()
+}
+
+func (e *InvalidParameterError) Unwrap() error { return ErrInvalidParameter }
+
+// NegativeCycleError reports a cycle of negative total weight, along which paths can be shortened without end.
+// It wraps ErrNegativeCycle.
+type NegativeCycleError struct {
+	Cycle graph.Path // The cycle, starting and ending at the same node, with its total weight as the distance.
+}
+
+func (e *NegativeCycleError) Error() string {
+	return algorithm_err.NegativeCycle(fmt.Sprintf("%v, weight: %g", e.Cycle.Nodes(), e.Cycle.Distance())).Error()
+}
+
+func (e *NegativeCycleError) Unwrap() error { return ErrNegativeCycle }
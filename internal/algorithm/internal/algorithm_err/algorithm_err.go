@@ -0,0 +1,25 @@
+package algorithm_err
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors wrapped by every error of the matching kind, for use with errors.Is.
+var (
+	ErrNotConverged     = errors.New("algorithm did not converge")
+	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrNegativeCycle    = errors.New("negative cycle")
+)
+
+func NotConverged(algorithmKey string, maxIter int) error {
+	return fmt.Errorf("%w: [%s after %d iterations]", ErrNotConverged, algorithmKey, maxIter)
+}
+
+func InvalidParameter(parameterKey, reason string) error {
+	return fmt.Errorf("%w: [(%s) %s]", ErrInvalidParameter, parameterKey, reason)
+}
+
+func NegativeCycle(cycleKey string) error {
+	return fmt.Errorf("%w: [%s]", ErrNegativeCycle, cycleKey)
+}
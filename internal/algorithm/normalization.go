@@ -0,0 +1,25 @@
+package algorithm
+
+// Normalization is an enumeration that defines how path-based centrality scores are scaled.
+type Normalization int
+
+// Enumeration values for Normalization.
+const (
+	NORMALIZE_NONE  Normalization = iota // Raw scores; every unordered pair of an undirected graph is counted once.
+	NORMALIZE_PAIRS                      // Scores divided by the number of node pairs that can contribute to them.
+	NORMALIZE_MAX                        // Raw scores divided by the largest score, so the maximum becomes 1.
+)
+
+// String converts a Normalization value to its string representation.
+func (n Normalization) String() string {
+	switch n {
+	case NORMALIZE_NONE:
+		return "No Normalization"
+	case NORMALIZE_PAIRS:
+		return "Pair Count Normalization"
+	case NORMALIZE_MAX:
+		return "Maximum Normalization"
+	default:
+		return "Unknown Normalization"
+	}
+}
@@ -0,0 +1,164 @@
+package algorithm
+
+import (
+	"math"
+
+	"github.com/elecbug/go-netrics/internal/graph"
+)
+
+// PageRank computes the PageRank of each node in the graph for a Unit.
+// PageRank models a random surfer who follows an outgoing edge with probability `damping`
+// and otherwise teleports to a node chosen from the personalization vector.
+// In weighted graphs, edges are followed in proportion to their weights.
+// The rank of dangling nodes (nodes without outgoing edges) is redistributed like a teleport.
+//
+// Parameters:
+//   - damping: The probability of following an edge, in [0, 1]. 0.85 is a common choice.
+//   - maxIter: The maximum number of power iterations.
+//   - tol: The convergence threshold on the L1 difference between successive iterates.
+//   - personalization: Teleport weights keyed by node identifier. Missing nodes get 0.
+//     If nil or empty, teleports are uniform.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the PageRank scores, summing to 1.
+//   - true if the iteration converged within maxIter iterations. Otherwise the last iterate is returned.
+//   - An *InvalidParameterError if damping is outside [0, 1], or if a personalization weight is negative
+//     or not finite, or the weights of the nodes of the graph do not sum to a positive value.
+func (u *Unit) PageRank(damping float64, maxIter int, tol float64, personalization map[graph.NodeID]float64) (map[graph.NodeID]float64, bool, error) {
+	return pageRank(u.snapshot(), 1, damping, maxIter, tol, personalization)
+}
+
+// PageRank computes the PageRank of each node in the graph for a ParallelUnit.
+// Each power iteration updates disjoint ranges of nodes in parallel.
+//
+// Parameters:
+//   - damping: The probability of following an edge, in [0, 1]. 0.85 is a common choice.
+//   - maxIter: The maximum number of power iterations.
+//   - tol: The convergence threshold on the L1 difference between successive iterates.
+//   - personalization: Teleport weights keyed by node identifier. Missing nodes get 0.
+//     If nil or empty, teleports are uniform.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the PageRank scores, summing to 1.
+//   - true if the iteration converged within maxIter iterations. Otherwise the last iterate is returned.
+//   - An *InvalidParameterError if damping is outside [0, 1], or if a personalization weight is negative
+//     or not finite, or the weights of the nodes of the graph do not sum to a positive value.
+func (pu *ParallelUnit) PageRank(damping float64, maxIter int, tol float64, personalization map[graph.NodeID]float64) (map[graph.NodeID]float64, bool, error) {
+	return pageRank(pu.snapshot(), pu.maxCore, damping, maxIter, tol, personalization)
+}
+
+// pageRank runs the PageRank power iteration over the adjacency view.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - workers: The number of goroutines used per iteration.
+//   - damping, maxIter, tol, personalization: See Unit.PageRank.
+//
+// Returns:
+//   - The PageRank scores keyed by node identifier, whether the iteration converged,
+//     and an error as described in Unit.PageRank.
+func pageRank(adj *adjacency, workers uint, damping float64, maxIter int, tol float64, personalization map[graph.NodeID]float64) (map[graph.NodeID]float64, bool, error) {
+	if math.IsNaN(damping) || damping < 0 || damping > 1 {
+		return nil, false, &InvalidParameterError{Parameter: "damping", Reason: "must be in [0, 1]"}
+	}
+
+	n := adj.size()
+
+	// Build the teleport distribution, uniform without personalization.
+	teleport := make([]float64, n)
+	sum := 0.0
+
+	for id, value := range personalization {
+		if math.IsNaN(value) || math.IsInf(value, 0) || value < 0 {
+			return nil, false, &InvalidParameterError{Parameter: "personalization", Reason: "weights must be finite and non-negative"}
+		}
+
+		if v, ok := adj.position(id); ok {
+			teleport[v] = value
+			sum += value
+		}
+	}
+
+	if len(personalization) > 0 && !(sum > 0 && !math.IsInf(sum, 0)) {
+		return nil, false, &InvalidParameterError{Parameter: "personalization", Reason: "weights of the nodes must sum to a positive finite value"}
+	}
+
+	result := make(map[graph.NodeID]float64)
+
+	if n == 0 {
+		return result, true, nil
+	}
+
+	for v := range teleport {
+		if sum > 0 {
+			teleport[v] /= sum
+		} else {
+			teleport[v] = 1 / float64(n)
+		}
+	}
+
+	// Compute the total outgoing weight of every node; nodes without any are dangling.
+	strength := make([]float64, n)
+
+	for v := range adj.out {
+		for k := range adj.out[v] {
+			strength[v] += adj.strength[v][k]
+		}
+	}
+
+	rank := make([]float64, n)
+
+	for v := range rank {
+		rank[v] = 1 / float64(n)
+	}
+
+	converged := false
+
+	for iter := 0; iter < maxIter; iter++ {
+		// Rank held by dangling nodes is redistributed like a teleport.
+		dangling := 0.0
+
+		for v := range rank {
+			if strength[v] == 0 {
+				dangling += rank[v]
+			}
+		}
+
+		next := make([]float64, n)
+
+		// Pull rank from the incoming edges, with every worker owning a disjoint range of nodes.
+		parallelRange(n, workers, func(lo, hi int) {
+			for w := lo; w < hi; w++ {
+				value := 0.0
+
+				for k, v := range adj.in[w] {
+					if strength[v] > 0 {
+						value += rank[v] * adj.inStrength[w][k] / strength[v]
+					}
+				}
+
+				next[w] = damping*(value+dangling*teleport[w]) + (1-damping)*teleport[w]
+			}
+		})
+
+		// Check for convergence
+		diff := 0.0
+		for v := range rank {
+			diff += math.Abs(next[v] - rank[v])
+		}
+
+		rank = next
+
+		if diff < tol {
+			converged = true
+			break
+		}
+	}
+
+	// Convert to map for output
+	for v, value := range rank {
+		result[adj.id(v)] = value
+	}
+
+	return result, converged, nil
+}
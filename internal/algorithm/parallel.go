@@ -0,0 +1,106 @@
+package algorithm
+
+import (
+	"sync"
+
+	"github.com/elecbug/go-netrics/internal/graph"
+)
+
+// nodeScores evaluates a per-node score function for every live node of the graph.
+// With more than one worker, the nodes are distributed across goroutines.
+//
+// Parameters:
+//   - adj: The adjacency view of the graph.
+//   - workers: The number of goroutines to use; 0 or 1 runs sequentially.
+//   - score: The function computing the score of the node of the given row.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the computed scores.
+func nodeScores(adj *adjacency, workers uint, score func(v int) float64) map[graph.NodeID]float64 {
+	result := make(map[graph.NodeID]float64)
+
+	if workers <= 1 {
+		for v := 0; v < adj.size(); v++ {
+			result[adj.id(v)] = score(v)
+		}
+
+		return result
+	}
+
+	// Define a result type to collect the scores from the workers.
+	type nodeScore struct {
+		node  graph.NodeID
+		value float64
+	}
+
+	jobChan := make(chan int)
+	resultChan := make(chan nodeScore, workers)
+	var wg sync.WaitGroup
+
+	// Start worker goroutines to compute scores in parallel.
+	for i := uint(0); i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			for v := range jobChan {
+				resultChan <- nodeScore{node: adj.id(v), value: score(v)}
+			}
+		}()
+	}
+
+	// Generate one job for every live node.
+	go func() {
+		for v := 0; v < adj.size(); v++ {
+			jobChan <- v
+		}
+		close(jobChan)
+	}()
+
+	// Close the result channel after all workers finish.
+	go func() {
+		wg.Wait()
+		close(resultChan)
+	}()
+
+	// Collect results from workers.
+	for res := range resultChan {
+		result[res.node] = res.value
+	}
+
+	return result
+}
+
+// parallelRange splits the index range [0, size) into contiguous chunks and
+// calls the function on each chunk from its own goroutine, waiting for all of them to finish.
+//
+// Parameters:
+//   - size: The length of the index range.
+//   - workers: The number of chunks; 0 or 1 runs the function once on the whole range.
+//   - fn: The function processing the half-open range [lo, hi).
+func parallelRange(size int, workers uint, fn func(lo, hi int)) {
+	if workers <= 1 || size == 0 {
+		fn(0, size)
+		return
+	}
+
+	chunk := (size + int(workers) - 1) / int(workers)
+	var wg sync.WaitGroup
+
+	for lo := 0; lo < size; lo += chunk {
+		hi := lo + chunk
+
+		if hi > size {
+			hi = size
+		}
+
+		wg.Add(1)
+
+		go func(lo, hi int) {
+			defer wg.Done()
+			fn(lo, hi)
+		}(lo, hi)
+	}
+
+	wg.Wait()
+}
@@ -16,17 +16,15 @@ import (
 //
 // Notes:
 //   - If the graph or the Unit has been updated, shortest paths are recomputed before the search.
+//   - Negative weights are handled with Johnson's algorithm. If the graph has a negative cycle,
+//     no path exists for any pair; PathError reports the cycle.
 func (u *Unit) ShortestPath(from, to graph.NodeID) graph.Path {
-	g := u.graph
-
-	if !g.IsUpdated() || !u.updated {
+	if !u.isCurrent() {
 		u.computePaths()
 	}
 
-	for _, p := range u.shortestPaths {
-		if p.Nodes()[0] == from && p.Nodes()[len(p.Nodes())-1] == to {
-			return p
-		}
+	if p, ok := u.findPath(from, to); ok {
+		return p
 	}
 
 	return *graph.NewPath(graph.INF, []graph.NodeID{from, to})
@@ -44,22 +42,52 @@ func (u *Unit) ShortestPath(from, to graph.NodeID) graph.Path {
 //
 // Notes:
 //   - If the graph or the ParallelUnit has been updated, shortest paths are recomputed in parallel before the search.
+//   - Negative weights are handled with Johnson's algorithm. If the graph has a negative cycle,
+//     no path exists for any pair; PathError reports the cycle.
 func (pu *ParallelUnit) ShortestPath(from, to graph.NodeID) graph.Path {
-	g := pu.graph
-
-	if !g.IsUpdated() || !pu.updated {
+	if !pu.isCurrent() {
 		pu.computePaths()
 	}
 
-	for _, p := range pu.shortestPaths {
-		if p.Nodes()[0] == from && p.Nodes()[len(p.Nodes())-1] == to {
-			return p
-		}
+	if p, ok := pu.findPath(from, to); ok {
+		return p
 	}
 
 	return *graph.NewPath(graph.INF, []graph.NodeID{from, to})
 }
 
+// PathError reports whether the shortest paths behind ShortestPath, Diameter and the path length measures
+// could be computed for a Unit.
+//
+// Returns:
+//   - nil if the paths are well defined, or a *NegativeCycleError carrying a cycle of negative total weight.
+//
+// Notes:
+//   - If the graph or the Unit has been updated, shortest paths are recomputed first.
+func (u *Unit) PathError() error {
+	if !u.isCurrent() {
+		u.computePaths()
+	}
+
+	return u.pathErr
+}
+
+// PathError reports whether the shortest paths behind ShortestPath, Diameter and the path length measures
+// could be computed for a ParallelUnit.
+//
+// Returns:
+//   - nil if the paths are well defined, or a *NegativeCycleError carrying a cycle of negative total weight.
+//
+// Notes:
+//   - If the graph or the ParallelUnit has been updated, shortest paths are recomputed in parallel first.
+func (pu *ParallelUnit) PathError() error {
+	if !pu.isCurrent() {
+		pu.computePaths()
+	}
+
+	return pu.pathErr
+}
+
 // AverageShortestPathLength computes the average shortest path length in the graph.
 //
 // Returns:
@@ -68,9 +96,7 @@ func (pu *ParallelUnit) ShortestPath(from, to graph.NodeID) graph.Path {
 // Notes:
 //   - If no shortest paths are found, the function returns 0.
 func (u *Unit) AverageShortestPathLength() float64 {
-	g := u.graph
-
-	if !g.IsUpdated() || !u.updated {
+	if !u.isCurrent() {
 		// Recompute shortest paths if the graph or unit has been updated.
 		u.computePaths()
 	}
@@ -100,9 +126,7 @@ func (u *Unit) AverageShortestPathLength() float64 {
 // Notes:
 //   - If no shortest paths are found, the function returns 0.
 func (pu *ParallelUnit) AverageShortestPathLength() float64 {
-	g := pu.graph
-
-	if !g.IsUpdated() || !pu.updated {
+	if !pu.isCurrent() {
 		// Recompute shortest paths if the graph or unit has been updated.
 		pu.computePaths()
 	}
@@ -134,14 +158,17 @@ func (pu *ParallelUnit) AverageShortestPathLength() float64 {
 // Notes:
 //   - The percentile is calculated based on the sorted list of shortest paths.
 //   - If the percentile is out of range, it is clamped to valid indices.
+//   - If no shortest paths are found, the function returns INF.
 func (u *Unit) PercentileShortestPathLength(percentile float64) graph.Distance {
-	g := u.graph
-
-	if !g.IsUpdated() || !u.updated {
+	if !u.isCurrent() {
 		// Recompute shortest paths if the graph or unit has been updated.
 		u.computePaths()
 	}
 
+	if len(u.shortestPaths) == 0 {
+		return graph.INF // No pair of nodes is connected, or PathError reports a negative cycle.
+	}
+
 	// Calculate the index for the desired percentile.
 	index := int(percentile * float64(len(u.shortestPaths)))
 
@@ -167,14 +194,17 @@ func (u *Unit) PercentileShortestPathLength(percentile float64) graph.Distance {
 // Notes:
 //   - The percentile is calculated based on the sorted list of shortest paths.
 //   - If the percentile is out of range, it is clamped to valid indices.
+//   - If no shortest paths are found, the function returns INF.
 func (pu *ParallelUnit) PercentileShortestPathLength(percentile float64) graph.Distance {
-	g := pu.graph
-
-	if !g.IsUpdated() || !pu.updated {
+	if !pu.isCurrent() {
 		// Recompute shortest paths if the graph or unit has been updated.
 		pu.computePaths()
 	}
 
+	if len(pu.shortestPaths) == 0 {
+		return graph.INF // No pair of nodes is connected, or PathError reports a negative cycle.
+	}
+
 	// Calculate the index for the desired percentile.
 	index := int(percentile * float64(len(pu.shortestPaths)))
 
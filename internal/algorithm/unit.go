@@ -6,14 +6,32 @@ import (
 
 // Unit represents a computation unit for graph algorithms.
 // It stores shortest paths within the graph and performs computations.
+// The graph may be modified concurrently while a Unit reads it, but a Unit itself caches results
+// and must not be used from several goroutines at once.
 //
 // Fields:
 //   - shortestPaths: A slice of all shortest paths in the graph, sorted by distance in ascending order.
+//   - pathIndex: The position of each path in `shortestPaths`, keyed by its endpoints.
+//   - pathErr: The error of the last shortest path computation, such as a negative cycle.
 //   - graph: A reference to the graph on which computations are performed.
+//   - version: The version of the graph the shortest paths were computed from.
+//   - weightKey: The edge attribute used as the edge weight, or "" to use edge distances.
+//   - collapse: How parallel edges of a multigraph are combined by degree, random-walk and spectral measures.
 type Unit struct {
-	shortestPaths []graph.Path // Stores the shortest paths for the graph, sorted by distance in ascending order.
-	graph         *graph.Graph // A reference to the graph associated with this computation unit.
-	updated       bool         // Update information for shortest paths
+	shortestPaths []graph.Path    // Stores the shortest paths for the graph, sorted by distance in ascending order.
+	pathIndex     map[pathKey]int // Position of each path in shortestPaths, keyed by its endpoints.
+	pathErr       error           // Error of the last shortest path computation.
+	graph         *graph.Graph    // A reference to the graph associated with this computation unit.
+	updated       bool            // Update information for shortest paths
+	version       uint64          // Version of the graph the shortest paths were computed from.
+	weightKey     string          // Edge attribute used as the edge weight, or "" for edge distances.
+	collapse      Collapse        // How parallel edges are combined by degree, random-walk and spectral measures.
+}
+
+// pathKey identifies a stored shortest path by its source and destination nodes.
+type pathKey struct {
+	from graph.NodeID // The identifier of the source node.
+	to   graph.NodeID // The identifier of the destination node.
 }
 
 // ParallelUnit extends Unit for parallel computation of graph algorithms.
@@ -56,3 +74,51 @@ func NewParallelUnit(g *graph.Graph, core uint) *ParallelUnit {
 		maxCore: core,        // Set the maximum number of cores for parallel processing.
 	}
 }
+
+// SetWeightAttribute selects the edge attribute that algorithms use as the edge weight.
+// Edges without a valid numeric value under the key keep their distance.
+// Selecting an attribute makes path-based algorithms take weights into account even for unweighted graph types.
+//
+// Parameters:
+//   - key: The name of the edge attribute, or "" to use edge distances again.
+func (u *Unit) SetWeightAttribute(key string) {
+	u.weightKey = key
+	u.updated = false // Cached shortest paths depend on the weights.
+}
+
+// WeightAttribute returns the edge attribute used as the edge weight, or "" if edge distances are used.
+func (u *Unit) WeightAttribute() string {
+	return u.weightKey
+}
+
+// SetCollapse selects how the parallel edges of a multigraph are combined
+// by degree, random-walk and spectral measures. The default is COLLAPSE_MIN.
+// Path-based measures always follow the lightest parallel edge, so cached shortest paths stay valid.
+//
+// Parameters:
+//   - policy: The collapse policy to use.
+func (u *Unit) SetCollapse(policy Collapse) {
+	u.collapse = policy
+}
+
+// Collapse returns how the parallel edges of a multigraph are combined by degree, random-walk and spectral measures.
+func (u *Unit) Collapse() Collapse {
+	return u.collapse
+}
+
+// isCurrent reports whether the cached shortest paths were computed from the current version of the graph.
+// A version counter is used instead of the graph's shared `updated` flag,
+// so several units over the same graph never mistake each other's computations for their own.
+func (u *Unit) isCurrent() bool {
+	return u.updated && u.version == u.graph.Version()
+}
+
+// snapshot builds the adjacency view that a computation works on.
+// The view is taken under the graph's read lock, so a computation sees one consistent state of the graph
+// even if the graph is modified concurrently.
+//
+// Returns:
+//   - A pointer to the adjacency view of the unit's graph.
+func (u *Unit) snapshot() *adjacency {
+	return newAdjacency(u.graph, u.weightKey, u.collapse)
+}
@@ -0,0 +1,156 @@
+package graph
+
+import (
+	"sort"
+)
+
+// Adjacency is a read-only snapshot of the edges of a graph in compressed sparse row (CSR) form.
+// The outgoing edges of row i are stored in `targets[offsets[i]:offsets[i+1]]`, sorted by target,
+// and the incoming edges are stored the same way in the `in*` fields.
+// Parallel edges of a multigraph appear once each, next to each other and sorted by weight.
+// Rows are the positions of a dense Index, so removed identifiers take no space.
+// Memory use is proportional to the number of nodes plus the number of edges.
+//
+// Fields:
+//   - graphType: The type of the graph the snapshot was taken from.
+//   - index: The mapping between rows and node identifiers.
+//   - version: The version of the graph the snapshot was taken from.
+//   - offsets, targets, weights: The outgoing edges of each row.
+//   - inOffsets, sources, inWeights: The incoming edges of each row.
+type Adjacency struct {
+	graphType GraphType  // The type of the graph the snapshot was taken from.
+	index     *Index     // The mapping between rows and node identifiers.
+	version   uint64     // The version of the graph the snapshot was taken from.
+	offsets   []int      // Start of each row's outgoing edges in targets; has one extra trailing entry.
+	targets   []int      // Target rows of the outgoing edges.
+	weights   []Distance // Weights of the outgoing edges.
+	inOffsets []int      // Start of each row's incoming edges in sources; has one extra trailing entry.
+	sources   []int      // Source rows of the incoming edges.
+	inWeights []Distance // Weights of the incoming edges.
+}
+
+// Adjacency takes a sparse snapshot of the graph's edges under the read lock,
+// so the snapshot is consistent even if the graph is modified concurrently.
+// Row i holds the edges of the node at position i of the graph's Index.
+//
+// Parameters:
+//   - weightKey: The edge attribute used as the edge weight, or "" to use edge distances.
+//     Edges without a valid numeric value under the key keep their distance.
+//
+// Returns a pointer to the newly created snapshot.
+func (g *Graph) Adjacency(weightKey string) *Adjacency {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	index := g.index()
+	size := index.Len()
+	adj := &Adjacency{
+		graphType: g.graphType,
+		index:     index,
+		version:   g.version,
+		offsets:   make([]int, size+1),
+		inOffsets: make([]int, size+1),
+	}
+
+	// Size every row from the node's outgoing edges and incoming-edge index.
+	for i, id := range index.ids {
+		node := g.nodes.nodes[id]
+		adj.offsets[i+1] = adj.offsets[i] + len(node.edges)
+		adj.inOffsets[i+1] = adj.inOffsets[i] + len(node.in)
+	}
+
+	edgeCount := adj.offsets[size]
+	adj.targets = make([]int, edgeCount)
+	adj.weights = make([]Distance, edgeCount)
+	adj.sources = make([]int, edgeCount)
+	adj.inWeights = make([]Distance, edgeCount)
+
+	// Fill the outgoing rows and sort each of them by target, and parallel edges by weight.
+	for i, id := range index.ids {
+		lo, hi := adj.offsets[i], adj.offsets[i+1]
+		k := lo
+
+		for _, e := range g.nodes.nodes[id].edges {
+			adj.targets[k], _ = index.Position(e.to)
+			adj.weights[k] = e.weight(weightKey, g.graphType == DIRECTED_WEIGHTED)
+			k++
+		}
+
+		sort.Sort(csrRow{adj.targets[lo:hi], adj.weights[lo:hi]})
+	}
+
+	// Fill the incoming rows by transposing the outgoing rows. Sources are visited in ascending order,
+	// so every incoming row comes out sorted, with one entry per parallel edge.
+	next := append([]int(nil), adj.inOffsets[:size]...)
+
+	for source := 0; source < size; source++ {
+		targets, weights := adj.Out(source)
+
+		for k, target := range targets {
+			adj.sources[next[target]] = source
+			adj.inWeights[next[target]] = weights[k]
+			next[target]++
+		}
+	}
+
+	return adj
+}
+
+// Type returns the type of the graph the snapshot was taken from.
+func (adj *Adjacency) Type() GraphType {
+	return adj.graphType
+}
+
+// Size returns the number of rows in the snapshot, which is the number of nodes.
+func (adj *Adjacency) Size() int {
+	return adj.index.Len()
+}
+
+// Version returns the version of the graph the snapshot was taken from (see Graph.Version).
+func (adj *Adjacency) Version() uint64 {
+	return adj.version
+}
+
+// Index returns the mapping between the rows of the snapshot and node identifiers.
+func (adj *Adjacency) Index() *Index {
+	return adj.index
+}
+
+// Out returns the outgoing edges of a row.
+// The returned slices share memory with the snapshot and must not be modified.
+//
+// Returns the target rows in ascending order and the weights of the edges, aligned index by index.
+func (adj *Adjacency) Out(i int) ([]int, []Distance) {
+	lo, hi := adj.offsets[i], adj.offsets[i+1]
+	return adj.targets[lo:hi], adj.weights[lo:hi]
+}
+
+// In returns the incoming edges of a row.
+// The returned slices share memory with the snapshot and must not be modified.
+//
+// Returns the source rows in ascending order and the weights of the edges, aligned index by index.
+func (adj *Adjacency) In(i int) ([]int, []Distance) {
+	lo, hi := adj.inOffsets[i], adj.inOffsets[i+1]
+	return adj.sources[lo:hi], adj.inWeights[lo:hi]
+}
+
+// csrRow sorts the targets (or sources) of one CSR row together with their weights, implementing sort.Interface.
+type csrRow struct {
+	targets []int      // Target rows of the edges.
+	weights []Distance // Weights of the edges.
+}
+
+func (r csrRow) Len() int { return len(r.targets) }
+
+func (r csrRow) Less(i, j int) bool {
+	if r.targets[i] != r.targets[j] {
+		return r.targets[i] < r.targets[j]
+	}
+
+	return r.weights[i] < r.weights[j]
+}
+
+func (r csrRow) Swap(i, j int) {
+	r.targets[i], r.targets[j] = r.targets[j], r.targets[i]
+	r.weights[i], r.weights[j] = r.weights[j], r.weights[i]
+}
@@ -0,0 +1,560 @@
+package graph
+
+import (
+	"time"
+)
+
+// Attributes holds key/value properties attached to a node or an edge, such as a type, a timestamp,
+// a label or a capacity. Values can be read back with the typed getters.
+type Attributes map[string]any
+
+// Float returns the value stored under `key` as a float64.
+// Any integer, floating-point or Distance value is converted.
+//
+// Returns the value and true, or 0 and false if the key is missing or the value is not numeric.
+func (a Attributes) Float(key string) (float64, bool) {
+	switch v := a[key].(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case Distance:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// Int returns the value stored under `key` as an int.
+//
+// Returns the value and true, or 0 and false if the key is missing or the value is not an integer.
+func (a Attributes) Int(key string) (int, bool) {
+	switch v := a[key].(type) {
+	case int:
+		return v, true
+	case int8:
+		return int(v), true
+	case int16:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	case uint:
+		return int(v), true
+	case uint8:
+		return int(v), true
+	case uint16:
+		return int(v), true
+	case uint32:
+		return int(v), true
+	case uint64:
+		return int(v), true
+	default:
+		return 0, false
+	}
+}
+
+// Text returns the value stored under `key` as a string.
+//
+// Returns the value and true, or "" and false if the key is missing or the value is not a string.
+func (a Attributes) Text(key string) (string, bool) {
+	v, ok := a[key].(string)
+	return v, ok
+}
+
+// Bool returns the value stored under `key` as a bool.
+//
+// Returns the value and true, or false and false if the key is missing or the value is not a bool.
+func (a Attributes) Bool(key string) (bool, bool) {
+	v, ok := a[key].(bool)
+	return v, ok
+}
+
+// Time returns the value stored under `key` as a time.Time.
+//
+// Returns the value and true, or the zero time and false if the key is missing or the value is not a time.Time.
+func (a Attributes) Time(key string) (time.Time, bool) {
+	v, ok := a[key].(time.Time)
+	return v, ok
+}
+
+// clone returns a shallow copy of the attributes, or nil if there are none.
+func (a Attributes) clone() Attributes {
+	if len(a) == 0 {
+		return nil
+	}
+
+	result := make(Attributes, len(a))
+
+	for key, value := range a {
+		result[key] = value
+	}
+
+	return result
+}
+
+// SetNodeAttribute stores a property on a node, replacing any previous value under the same key.
+//
+// Parameters:
+//   - identifier: The identifier of the node.
+//   - key: The name of the property.
+//   - value: The value of the property.
+//
+// Returns an error if the node does not exist.
+func (g *Graph) SetNodeAttribute(identifier NodeID, key string, value any) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.writable("SetNodeAttribute"); err != nil {
+		return err
+	}
+
+	n := g.own(identifier)
+
+	if n == nil {
+		return &NotExistNodeError{ID: identifier}
+	}
+
+	if n.attributes == nil {
+		n.attributes = make(Attributes)
+	}
+
+	n.attributes[key] = value
+
+	return nil
+}
+
+// NodeAttribute retrieves a property of a node.
+//
+// Parameters:
+//   - identifier: The identifier of the node.
+//   - key: The name of the property.
+//
+// Returns the value and an error if the node or the property does not exist.
+func (g *Graph) NodeAttribute(identifier NodeID, key string) (any, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	n := g.nodes.find(identifier)
+
+	if n == nil {
+		return nil, &NotExistNodeError{ID: identifier}
+	}
+
+	value, ok := n.attributes[key]
+
+	if !ok {
+		return nil, &NotExistAttributeError{Owner: identifier, Key: key}
+	}
+
+	return value, nil
+}
+
+// NodeAttributes retrieves a copy of all properties of a node.
+//
+// Parameters:
+//   - identifier: The identifier of the node.
+//
+// Returns the properties and an error if the node does not exist.
+func (g *Graph) NodeAttributes(identifier NodeID) (Attributes, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	n := g.nodes.find(identifier)
+
+	if n == nil {
+		return nil, &NotExistNodeError{ID: identifier}
+	}
+
+	return n.attributes.clone(), nil
+}
+
+// RemoveNodeAttribute deletes a property from a node.
+//
+// Parameters:
+//   - identifier: The identifier of the node.
+//   - key: The name of the property.
+//
+// Returns an error if the node or the property does not exist.
+func (g *Graph) RemoveNodeAttribute(identifier NodeID, key string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.writable("RemoveNodeAttribute"); err != nil {
+		return err
+	}
+
+	n := g.own(identifier)
+
+	if n == nil {
+		return &NotExistNodeError{ID: identifier}
+	}
+
+	if _, ok := n.attributes[key]; !ok {
+		return &NotExistAttributeError{Owner: identifier, Key: key}
+	}
+
+	delete(n.attributes, key)
+
+	return nil
+}
+
+// SetEdgeAttribute stores a property on an edge, replacing any previous value under the same key.
+// For undirected graphs, the property is visible from both directions of the edge.
+// In a multigraph, the edge added first is changed; see SetEdgeAttributeByID for the others.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - key: The name of the property.
+//   - value: The value of the property.
+//
+// Returns an error if the edge does not exist.
+//
+// Notes:
+//   - The graph's `updated` flag is set to false, since the property may be used as an edge weight.
+func (g *Graph) SetEdgeAttribute(from, to NodeID, key string, value any) error {
+	return g.setEdgeAttribute("SetEdgeAttribute", func() ([]*edge, error) {
+		return g.ownEdgePair(from, to)
+	}, key, value)
+}
+
+// SetEdgeAttributeByID stores a property on one edge between two nodes, identified by its EdgeID,
+// replacing any previous value under the same key.
+// For undirected graphs, the property is visible from both directions of the edge.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - id: The identifier of the edge, as returned by InsertEdge, FindEdges or Edges.
+//   - key: The name of the property.
+//   - value: The value of the property.
+//
+// Returns an error if the nodes or the edge do not exist.
+//
+// Notes:
+//   - The graph's `updated` flag is set to false, since the property may be used as an edge weight.
+func (g *Graph) SetEdgeAttributeByID(from, to NodeID, id EdgeID, key string, value any) error {
+	return g.setEdgeAttribute("SetEdgeAttributeByID", func() ([]*edge, error) {
+		return g.ownEdgePairByID(from, to, id)
+	}, key, value)
+}
+
+// EdgeAttribute retrieves a property of an edge.
+// In a multigraph, the edge added first is used; see EdgeAttributeByID for the others.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - key: The name of the property.
+//
+// Returns the value and an error if the edge or the property does not exist.
+func (g *Graph) EdgeAttribute(from, to NodeID, key string) (any, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	edges, err := g.findEdgePair(from, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return edgeAttribute(edges[0], from, to, key)
+}
+
+// EdgeAttributeByID retrieves a property of one edge between two nodes, identified by its EdgeID.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - id: The identifier of the edge, as returned by InsertEdge, FindEdges or Edges.
+//   - key: The name of the property.
+//
+// Returns the value and an error if the nodes, the edge or the property do not exist.
+func (g *Graph) EdgeAttributeByID(from, to NodeID, id EdgeID, key string) (any, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	edges, err := g.findEdgePairByID(from, to, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return edgeAttribute(edges[0], from, to, key)
+}
+
+// EdgeAttributes retrieves a copy of all properties of an edge.
+// In a multigraph, the edge added first is used; see EdgeAttributesByID for the others.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//
+// Returns the properties and an error if the edge does not exist.
+func (g *Graph) EdgeAttributes(from, to NodeID) (Attributes, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	edges, err := g.findEdgePair(from, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return edges[0].attributes.clone(), nil
+}
+
+// EdgeAttributesByID retrieves a copy of all properties of one edge between two nodes, identified by its EdgeID.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - id: The identifier of the edge, as returned by InsertEdge, FindEdges or Edges.
+//
+// Returns the properties and an error if the nodes or the edge do not exist.
+func (g *Graph) EdgeAttributesByID(from, to NodeID, id EdgeID) (Attributes, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	edges, err := g.findEdgePairByID(from, to, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return edges[0].attributes.clone(), nil
+}
+
+// RemoveEdgeAttribute deletes a property from an edge.
+// In a multigraph, the edge added first is changed; see RemoveEdgeAttributeByID for the others.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - key: The name of the property.
+//
+// Returns an error if the edge or the property does not exist.
+func (g *Graph) RemoveEdgeAttribute(from, to NodeID, key string) error {
+	return g.removeEdgeAttribute("RemoveEdgeAttribute", func() ([]*edge, error) {
+		return g.ownEdgePair(from, to)
+	}, from, to, key)
+}
+
+// RemoveEdgeAttributeByID deletes a property from one edge between two nodes, identified by its EdgeID.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - id: The identifier of the edge, as returned by InsertEdge, FindEdges or Edges.
+//   - key: The name of the property.
+//
+// Returns an error if the nodes, the edge or the property do not exist.
+func (g *Graph) RemoveEdgeAttributeByID(from, to NodeID, id EdgeID, key string) error {
+	return g.removeEdgeAttribute("RemoveEdgeAttributeByID", func() ([]*edge, error) {
+		return g.ownEdgePairByID(from, to, id)
+	}, from, to, key)
+}
+
+// setEdgeAttribute stores a property on the copies of an edge.
+//
+// Parameters:
+//   - operation: The name of the calling method, for the read-only error.
+//   - pair: Finds and owns the copies of the edge. It is called with the write lock held.
+//   - key: The name of the property.
+//   - value: The value of the property.
+//
+// Returns an error if the graph is read-only or the edge does not exist.
+func (g *Graph) setEdgeAttribute(operation string, pair func() ([]*edge, error), key string, value any) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.writable(operation); err != nil {
+		return err
+	}
+
+	edges, err := pair()
+
+	if err != nil {
+		return err
+	}
+
+	for _, e := range edges {
+		if e.attributes == nil {
+			e.attributes = make(Attributes)
+		}
+
+		e.attributes[key] = value
+	}
+
+	g.modified() // Mark the graph as modified.
+
+	return nil
+}
+
+// edgeAttribute reads a property of a stored edge.
+//
+// Parameters:
+//   - e: The edge.
+//   - from, to: The endpoints of the edge, for the missing-property error.
+//   - key: The name of the property.
+//
+// Returns the value and an error if the property does not exist.
+func edgeAttribute(e *edge, from, to NodeID, key string) (any, error) {
+	value, ok := e.attributes[key]
+
+	if !ok {
+		return nil, &NotExistAttributeError{Owner: NewEdgeKey(from, to, true), Key: key}
+	}
+
+	return value, nil
+}
+
+// removeEdgeAttribute deletes a property from the copies of an edge.
+//
+// Parameters:
+//   - operation: The name of the calling method, for the read-only error.
+//   - pair: Finds and owns the copies of the edge. It is called with the write lock held.
+//   - from, to: The endpoints of the edge, for the missing-property error.
+//   - key: The name of the property.
+//
+// Returns an error if the graph is read-only, or the edge or the property does not exist.
+func (g *Graph) removeEdgeAttribute(operation string, pair func() ([]*edge, error), from, to NodeID, key string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.writable(operation); err != nil {
+		return err
+	}
+
+	edges, err := pair()
+
+	if err != nil {
+		return err
+	}
+
+	if _, ok := edges[0].attributes[key]; !ok {
+		return &NotExistAttributeError{Owner: NewEdgeKey(from, to, true), Key: key}
+	}
+
+	for _, e := range edges {
+		delete(e.attributes, key)
+	}
+
+	g.modified() // Mark the graph as modified.
+
+	return nil
+}
+
+// WeightMatrix converts the graph to an adjacency matrix whose entries are taken from an edge property.
+// Edges without a valid numeric value under `key` keep their distance.
+//
+// Parameters:
+//   - key: The name of the edge property to use as the weight.
+//
+// Returns a Matrix where each element represents the weight between two nodes, or INF if they are not connected.
+func (g *Graph) WeightMatrix(key string) Matrix {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	matrix := g.matrix()
+	seen := make(map[EdgeKey]bool)
+
+	// Keep the smallest weight of parallel edges, overwriting the distances copied by matrix.
+	for fromID, from := range g.nodes.nodes {
+		for _, e := range from.edges {
+			pair := EdgeKey{From: fromID, To: e.to}
+
+			if w := e.weight(key, g.graphType == DIRECTED_WEIGHTED); !seen[pair] || w < matrix[fromID][e.to] {
+				matrix[fromID][e.to] = w
+				seen[pair] = true
+			}
+		}
+	}
+
+	return matrix
+}
+
+// findEdgePair finds the stored edge between two nodes, together with its reverse copy in undirected graphs.
+// In a multigraph, the edge added first is found.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//
+// Returns the edge (first) and its reverse copy if any, or an error if the nodes or the edge do not exist.
+func (g *Graph) findEdgePair(from, to NodeID) ([]*edge, error) {
+	return g.lookupEdgePair(from, to, func(n *Node) *edge { return n.findEdge(to) })
+}
+
+// findEdgePairByID finds one stored edge between two nodes by its EdgeID, together with its reverse copy
+// in undirected graphs.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - id: The identifier of the edge.
+//
+// Returns the edge (first) and its reverse copy if any, or an error if the nodes or the edge do not exist.
+func (g *Graph) findEdgePairByID(from, to NodeID, id EdgeID) ([]*edge, error) {
+	return g.lookupEdgePair(from, to, func(n *Node) *edge { return n.findEdgeByID(to, id) })
+}
+
+// lookupEdgePair finds an edge leaving `from` with the given search, together with its reverse copy
+// in undirected graphs.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - find: Selects the edge among those leaving `from`, or returns nil.
+//
+// Returns the edge (first) and its reverse copy if any, or an error if the nodes or the edge do not exist.
+func (g *Graph) lookupEdgePair(from, to NodeID, find func(*Node) *edge) ([]*edge, error) {
+	f := g.nodes.find(from)
+	t := g.nodes.find(to)
+
+	// Ensure both nodes exist in the graph.
+	if f == nil {
+		return nil, &NotExistNodeError{ID: from}
+	}
+	if t == nil {
+		return nil, &NotExistNodeError{ID: to}
+	}
+
+	e := find(f)
+
+	if e == nil {
+		return nil, &NotExistEdgeError{From: from, To: to}
+	}
+
+	edges := []*edge{e}
+
+	if (g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED) && from != to {
+		if reverse := t.findEdgeByID(from, e.id); reverse != nil {
+			edges = append(edges, reverse)
+		}
+	}
+
+	return edges, nil
+}
@@ -0,0 +1,231 @@
+package graph
+
+import (
+	"errors"
+)
+
+// Builder collects nodes and edges in batches and turns them into a Graph in one step.
+// Unlike repeated AddNode and AddWeightEdge calls, it takes no locks, deduplicates edges with a hash set
+// instead of scanning edge lists, and allocates every edge list with its final size.
+// A Builder is not safe for concurrent use.
+//
+// Fields:
+//   - graphType: The type of the graph to build.
+//   - options: The options of the graph to build.
+//   - names: The names of the nodes; the node at position i gets the identifier i.
+//   - edges: The edges in the order they were added.
+//   - strict: Whether Build fails on invalid entries instead of skipping them.
+type Builder struct {
+	graphType GraphType // The type of the graph to build.
+	options   Option    // The options of the graph to build.
+	names     []string  // The names of the nodes, indexed by identifier.
+	edges     []Edge    // The edges in the order they were added.
+	strict    bool      // Whether Build fails on invalid entries.
+}
+
+// NewBuilder creates a Builder for a graph of the given type.
+//
+// Parameters:
+//   - graphType: The type of the graph (from the GraphType enumeration).
+//   - options: Optional rules for the graph to enforce (see Option).
+//
+// Returns a pointer to the newly created Builder.
+func NewBuilder(graphType GraphType, options ...Option) *Builder {
+	b := &Builder{graphType: graphType}
+
+	for _, option := range options {
+		b.options |= option
+	}
+
+	return b
+}
+
+// Grow reserves room for more nodes and edges, so that adding them in several batches does not reallocate.
+//
+// Parameters:
+//   - nodes: The number of nodes that will be added.
+//   - edges: The number of edges that will be added.
+func (b *Builder) Grow(nodes, edges int) {
+	if free := cap(b.names) - len(b.names); free < nodes {
+		b.names = append(make([]string, 0, len(b.names)+nodes), b.names...)
+	}
+
+	if free := cap(b.edges) - len(b.edges); free < edges {
+		b.edges = append(make([]Edge, 0, len(b.edges)+edges), b.edges...)
+	}
+}
+
+// SetStrict selects how Build treats invalid entries.
+//
+// Parameters:
+//   - strict: If true, Build returns every problem at once and no graph.
+//     If false, the default, invalid edges are skipped and returned as an error alongside the graph.
+//     Names repeated under UNIQUE_NAMES fail the build in both modes, since options are fixed at creation.
+func (b *Builder) SetStrict(strict bool) {
+	b.strict = strict
+}
+
+// AddNodes adds a batch of nodes.
+//
+// Parameters:
+//   - names: The display names of the nodes.
+//
+// Returns the identifier of the first node. The nodes get consecutive identifiers in the order of `names`.
+func (b *Builder) AddNodes(names ...string) NodeID {
+	first := NodeID(len(b.names))
+	b.names = append(b.names, names...)
+
+	return first
+}
+
+// AddEdges adds a batch of edges. Their endpoints refer to identifiers returned by AddNodes.
+//
+// Parameters:
+//   - edges: The edges to add. `ID` is ignored, since identifiers are assigned by Build in the order of the edges.
+//     `Distance` is ignored for unweighted graphs, whose edges all have distance 1.
+func (b *Builder) AddEdges(edges ...Edge) {
+	b.edges = append(b.edges, edges...)
+}
+
+// Build creates the graph from the nodes and edges added so far. The Builder can be reused afterwards.
+// Edges between the same nodes are kept once, the first one added winning, unless the graph has the MULTIGRAPH option;
+// in undirected graphs, u->v and v->u are the same edge.
+//
+// Returns:
+//   - The finished graph, or nil if names repeat under UNIQUE_NAMES or, in strict mode, if any problem was found.
+//   - An error joining every problem found, each one of the structured errors AddNode and AddWeightEdge return:
+//     repeated names under UNIQUE_NAMES, unknown endpoints, invalid weights, and self-loops the graph does not allow.
+//     In non-strict mode, a graph returned with an error lacks exactly the edges the error reports.
+func (b *Builder) Build() (*Graph, error) {
+	n := len(b.names)
+	g := NewGraph(b.graphType, n, b.options)
+	undirected := b.graphType == UNDIRECTED_UNWEIGHTED || b.graphType == UNDIRECTED_WEIGHTED
+	weighted := b.graphType == DIRECTED_WEIGHTED || b.graphType == UNDIRECTED_WEIGHTED
+	problems := []error{}
+	nodes := make([]*Node, n)
+
+	for i, name := range b.names {
+		id := NodeID(i)
+		nodes[i] = &Node{identifier: id, name: name}
+		g.nodes.nodes[id] = nodes[i]
+		g.nodes.nameMap[name] = append(g.nodes.nameMap[name], id)
+
+		if g.HasOption(UNIQUE_NAMES) && len(g.nodes.nameMap[name]) > 1 {
+			problems = append(problems, &AlreadyNodeError{ID: g.nodes.nameMap[name][0], Name: name})
+		}
+	}
+
+	// Repeated names, the only problems found so far, break UNIQUE_NAMES, which cannot be dropped from the graph.
+	invalidNames := len(problems) > 0
+
+	// Filter and deduplicate the edges, counting the final length of every edge list.
+	var seen map[EdgeKey]struct{}
+
+	if !g.HasOption(MULTIGRAPH) {
+		seen = make(map[EdgeKey]struct{}, len(b.edges))
+	}
+
+	kept := make([]Edge, 0, len(b.edges))
+	outDegree := make([]int, n)
+	inDegree := make([]int, n)
+	stored := 0
+
+	for _, e := range b.edges {
+		if !weighted {
+			e.Distance = 1
+		}
+
+		if err := b.check(g, e); err != nil {
+			problems = append(problems, err)
+			continue
+		}
+
+		if seen != nil {
+			key := EdgeKey{From: e.From, To: e.To}
+
+			if undirected && key.From > key.To {
+				key.From, key.To = key.To, key.From
+			}
+
+			if _, exists := seen[key]; exists {
+				continue
+			}
+
+			seen[key] = struct{}{}
+		}
+
+		kept = append(kept, e)
+		outDegree[e.From]++
+		inDegree[e.To]++
+		stored++
+
+		if undirected && e.From != e.To {
+			outDegree[e.To]++
+			inDegree[e.From]++
+			stored++
+		}
+	}
+
+	if (b.strict || invalidNames) && len(problems) > 0 {
+		return nil, errors.Join(problems...)
+	}
+
+	for i, node := range nodes {
+		node.edges = make([]*edge, 0, outDegree[i])
+		node.in = make([]NodeID, 0, inDegree[i])
+	}
+
+	// Every stored edge lives in one allocation instead of one per edge.
+	slab := make([]edge, stored)
+	k := 0
+
+	link := func(id EdgeID, from, to NodeID, distance Distance) {
+		slab[k] = edge{id: id, to: to, distance: distance}
+		nodes[from].edges = append(nodes[from].edges, &slab[k])
+		nodes[to].in = append(nodes[to].in, from)
+		k++
+	}
+
+	for i, e := range kept {
+		link(EdgeID(i), e.From, e.To, e.Distance)
+
+		if undirected && e.From != e.To {
+			link(EdgeID(i), e.To, e.From, e.Distance)
+		}
+	}
+
+	g.nowID = NodeID(n)
+	g.nowEdgeID = EdgeID(len(kept))
+	g.edgeCount = len(kept)
+	g.modified()
+
+	// Without strict mode, the skipped edges are reported alongside the graph.
+	return g, errors.Join(problems...)
+}
+
+// check validates one edge against the graph being built.
+//
+// Parameters:
+//   - g: The graph being built, holding every node.
+//   - e: The edge to check.
+//
+// Returns the error AddWeightEdge would return for the edge, or nil if it is valid.
+func (b *Builder) check(g *Graph, e Edge) error {
+	if int(e.From) >= len(b.names) {
+		return &NotExistNodeError{ID: e.From}
+	}
+
+	if int(e.To) >= len(b.names) {
+		return &NotExistNodeError{ID: e.To}
+	}
+
+	if e.From == e.To && !g.HasOption(ALLOW_SELF_LOOPS) {
+		return &SelfEdgeError{ID: e.From}
+	}
+
+	if !g.validWeight(e.Distance) {
+		return &InvalidEdgeError{Type: g.graphType, Distance: e.Distance}
+	}
+
+	return nil
+}
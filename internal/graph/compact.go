@@ -0,0 +1,93 @@
+package graph
+
+// IDMap maps the node identifiers of one numbering to the identifiers of another.
+type IDMap map[NodeID]NodeID
+
+// Translate returns the identifier that a node identifier is mapped to.
+//
+// Returns:
+//   - The mapped identifier and true, or 0 and false if the identifier is not in the map.
+func (m IDMap) Translate(identifier NodeID) (NodeID, bool) {
+	result, ok := m[identifier]
+
+	return result, ok
+}
+
+// Inverse returns the mapping in the opposite direction.
+func (m IDMap) Inverse() IDMap {
+	result := make(IDMap, len(m))
+
+	for from, to := range m {
+		result[to] = from
+	}
+
+	return result
+}
+
+// Compact renumbers the nodes of the graph so that their identifiers are 0..n-1 without gaps.
+// Nodes keep their relative order, and the next node added receives the identifier n.
+//
+// Returns:
+//   - The mapping from the old identifier of every node to its new identifier.
+//   - An error if the graph is read-only.
+//
+// Notes:
+//   - Identifiers held outside the graph, including those in computed Units, are invalidated.
+//     Use the returned mapping to translate them.
+func (g *Graph) Compact() (IDMap, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.writable("Compact"); err != nil {
+		return nil, err
+	}
+
+	index := g.index()
+	mapping := make(IDMap, index.Len())
+
+	for i, id := range index.ids {
+		mapping[id] = NodeID(i)
+	}
+
+	nodes := make(map[NodeID]*Node, index.Len())
+
+	for id := range g.nodes.nodes {
+		node := g.own(id)
+		node.identifier = mapping[id]
+
+		for _, e := range node.edges {
+			e.to = mapping[e.to]
+		}
+
+		for i, from := range node.in {
+			node.in[i] = mapping[from]
+		}
+
+		nodes[node.identifier] = node
+	}
+
+	for name, ids := range g.nodes.nameMap {
+		for i, id := range ids {
+			g.nodes.nameMap[name][i] = mapping[id]
+		}
+	}
+
+	g.nodes.nodes = nodes
+	g.nowID = NodeID(index.Len())
+	g.modified() // Mark the graph as modified.
+
+	return mapping, nil
+}
+
+// sortedKeys returns the identifiers the mapping is defined for, in ascending order.
+func (m IDMap) sortedKeys() []NodeID {
+	keys := make([]NodeID, 0, len(m))
+
+	for id := range m {
+		keys = append(keys, id)
+	}
+
+	sortIDs(keys)
+
+	return keys
+}
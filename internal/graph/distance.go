@@ -5,21 +5,36 @@ import (
 )
 
 // Distance represents the weight of an edge in a graph.
-// It is defined as an unsigned integer for non-negative edge weights.
-type Distance uint
+// It is defined as a 64-bit floating-point number, so fractional weights such as latencies,
+// similarities or probabilities can be used directly.
+type Distance float64
 
 // INF is a constant representing infinity.
 // It is used to denote an unreachable state or maximum possible distance.
-const INF = Distance(math.MaxUint)
+// Go has no floating-point infinity constant, so it is the largest finite Distance; edge weights must stay below it.
+const INF = Distance(math.MaxFloat64)
 
 // ToInt converts the Distance type to an int.
 // This method is useful when an integer representation of the edge weight is required.
+// The fractional part is truncated.
 func (w Distance) ToInt() int {
-	return int(w.uint())
+	return int(w)
 }
 
-// uint returns the Distance as a uint type.
-// This allows direct access to the underlying unsigned integer value.
-func (w Distance) uint() uint {
-	return uint(w)
+// ToFloat converts the Distance type to a float64.
+func (w Distance) ToFloat() float64 {
+	return float64(w)
+}
+
+// IsValid reports whether the Distance can be used as an edge weight.
+// Valid weights are finite and non-negative.
+// DIRECTED_WEIGHTED graphs also accept negative weights; see IsFinite.
+func (w Distance) IsValid() bool {
+	return w.IsFinite() && w >= 0
+}
+
+// IsFinite reports whether the Distance lies strictly between -INF and INF, which excludes NaN and infinities.
+// Finite weights of any sign can be used in DIRECTED_WEIGHTED graphs.
+func (w Distance) IsFinite() bool {
+	return w > -INF && w < INF
 }
@@ -0,0 +1,15 @@
+package graph
+
+import (
+	"fmt"
+)
+
+// EdgeID represents a unique identifier assigned to edges in a graph.
+// Parallel edges of a multigraph share their endpoints but have different identifiers,
+// and the two stored directions of an undirected edge share one identifier.
+type EdgeID uint
+
+// String converts the EdgeID to its string representation.
+func (id EdgeID) String() string {
+	return fmt.Sprintf("%d", id)
+}
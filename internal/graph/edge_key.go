@@ -0,0 +1,29 @@
+package graph
+
+// EdgeKey identifies an edge of a graph by the identifiers of its endpoints.
+// For undirected graphs the same edge may be described with either endpoint first.
+type EdgeKey struct {
+	From NodeID // The identifier of the source node.
+	To   NodeID // The identifier of the destination node.
+}
+
+// NewEdgeKey creates an EdgeKey for the edge between two nodes.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - directed: If false, the endpoints are ordered so that `From` is the smaller identifier.
+//
+// Returns the EdgeKey describing the edge.
+func NewEdgeKey(from, to NodeID, directed bool) EdgeKey {
+	if !directed && to < from {
+		from, to = to, from
+	}
+
+	return EdgeKey{From: from, To: to}
+}
+
+// String converts the EdgeKey to its string representation.
+func (k EdgeKey) String() string {
+	return k.From.String() + " ---> " + k.To.String()
+}
@@ -0,0 +1,149 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/elecbug/go-netrics/internal/graph/internal/graph_err" // Custom error package
+)
+
+// Sentinel errors returned by Graph methods, wrapped in the structured error types below.
+// Use errors.Is to test for a kind of error and errors.As to retrieve its details.
+var (
+	ErrInvalidEdge       = graph_err.ErrInvalidEdge       // An edge does not fit the graph type.
+	ErrSelfEdge          = graph_err.ErrSelfEdge          // An edge would connect a node to itself.
+	ErrAlreadyEdge       = graph_err.ErrAlreadyEdge       // An edge already exists.
+	ErrNotExistEdge      = graph_err.ErrNotExistEdge      // An edge does not exist.
+	ErrAlreadyNode       = graph_err.ErrAlreadyNode       // A node already exists.
+	ErrNotExistNode      = graph_err.ErrNotExistNode      // A node does not exist.
+	ErrNotExistAttribute = graph_err.ErrNotExistAttribute // An attribute does not exist.
+	ErrReadOnly          = graph_err.ErrReadOnly          // The graph is a read-only snapshot.
+	ErrTypeMismatch      = graph_err.ErrTypeMismatch      // Two graphs combined by an operation have different types.
+	ErrPayloadType       = graph_err.ErrPayloadType       // A payload does not hold the requested type.
+)
+
+// InvalidEdgeError reports an edge whose weight does not fit the graph type. It wraps ErrInvalidEdge.
+type InvalidEdgeError struct {
+	Type     GraphType // The type of the graph.
+	Distance Distance  // The rejected weight.
+}
+
+func (e *InvalidEdgeError) Error() string {
+	return graph_err.InvalidEdge(e.Type.String(), fmt.Sprintf("weight: %g", e.Distance)).Error()
+}
+
+func (e *InvalidEdgeError) Unwrap() error { return ErrInvalidEdge }
+
+// SelfEdgeError reports an edge that would connect a node to itself. It wraps ErrSelfEdge.
+type SelfEdgeError struct {
+	ID NodeID // The identifier of the node.
+}
+
+func (e *SelfEdgeError) Error() string {
+	return graph_err.SelfEdge(e.ID.String()).Error()
+}
+
+func (e *SelfEdgeError) Unwrap() error { return ErrSelfEdge }
+
+// AlreadyEdgeError reports an edge that already exists. It wraps ErrAlreadyEdge.
+type AlreadyEdgeError struct {
+	From NodeID // The identifier of the source node.
+	To   NodeID // The identifier of the destination node.
+}
+
+func (e *AlreadyEdgeError) Error() string {
+	return graph_err.AlreadyEdge(e.From.String(), e.To.String()).Error()
+}
+
+func (e *AlreadyEdgeError) Unwrap() error { return ErrAlreadyEdge }
+
+// NotExistEdgeError reports an edge that does not exist. It wraps ErrNotExistEdge.
+type NotExistEdgeError struct {
+	From NodeID // The identifier of the source node.
+	To   NodeID // The identifier of the destination node.
+}
+
+func (e *NotExistEdgeError) Error() string {
+	return graph_err.NotExistEdge(e.From.String(), e.To.String()).Error()
+}
+
+func (e *NotExistEdgeError) Unwrap() error { return ErrNotExistEdge }
+
+// AlreadyNodeError reports a node that already exists. It wraps ErrAlreadyNode.
+type AlreadyNodeError struct {
+	ID   NodeID // The identifier of the existing node.
+	Name string // The name that is already used, or "" if the identifier is the conflict.
+}
+
+func (e *AlreadyNodeError) Error() string {
+	if e.Name != "" {
+		return graph_err.AlreadyNode(e.Name).Error()
+	}
+
+	return graph_err.AlreadyNode(e.ID.String()).Error()
+}
+
+func (e *AlreadyNodeError) Unwrap() error { return ErrAlreadyNode }
+
+// NotExistNodeError reports a node that does not exist. It wraps ErrNotExistNode.
+type NotExistNodeError struct {
+	ID   NodeID // The identifier of the node.
+	Name string // The name that was looked up, or "" if the node was looked up by identifier.
+}
+
+func (e *NotExistNodeError) Error() string {
+	if e.Name != "" {
+		return graph_err.NotExistNode(e.Name).Error()
+	}
+
+	return graph_err.NotExistNode(e.ID.String()).Error()
+}
+
+func (e *NotExistNodeError) Unwrap() error { return ErrNotExistNode }
+
+// NotExistAttributeError reports an attribute that does not exist. It wraps ErrNotExistAttribute.
+type NotExistAttributeError struct {
+	Owner fmt.Stringer // The NodeID of the node or the EdgeKey of the edge the attribute was looked up on.
+	Key   string       // The name of the attribute.
+}
+
+func (e *NotExistAttributeError) Error() string {
+	return graph_err.NotExistAttribute(e.Owner.String(), e.Key).Error()
+}
+
+func (e *NotExistAttributeError) Unwrap() error { return ErrNotExistAttribute }
+
+// ReadOnlyError reports a modification attempted on a read-only snapshot. It wraps ErrReadOnly.
+type ReadOnlyError struct {
+	Operation string // The name of the rejected operation.
+}
+
+func (e *ReadOnlyError) Error() string {
+	return graph_err.ReadOnly(e.Operation).Error()
+}
+
+func (e *ReadOnlyError) Unwrap() error { return ErrReadOnly }
+
+// TypeMismatchError reports two graphs of different types passed to the same operation. It wraps ErrTypeMismatch.
+type TypeMismatchError struct {
+	Expected GraphType // The type of the receiving graph.
+	Actual   GraphType // The type of the other graph.
+}
+
+func (e *TypeMismatchError) Error() string {
+	return graph_err.TypeMismatch(e.Expected.String(), e.Actual.String()).Error()
+}
+
+func (e *TypeMismatchError) Unwrap() error { return ErrTypeMismatch }
+
+// PayloadTypeError reports a node or edge payload that does not hold the type it was read as. It wraps ErrPayloadType.
+type PayloadTypeError struct {
+	Owner    fmt.Stringer // The NodeID of the node or the EdgeKey of the edge the payload was read from.
+	Expected string       // The requested type.
+	Actual   string       // The type of the stored payload.
+}
+
+func (e *PayloadTypeError) Error() string {
+	return graph_err.PayloadType(e.Owner.String(), e.Actual, e.Expected).Error()
+}
+
+func (e *PayloadTypeError) Unwrap() error { return ErrPayloadType }
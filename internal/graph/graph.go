@@ -2,20 +2,30 @@ package graph
 
 import (
 	"fmt"
-	"math"
-
-	"github.com/elecbug/go-netrics/internal/graph/internal/graph_err" // Custom error package
+	"sort"
+	"sync"
 )
 
 // Graph represents the core structure of a graph.
 // It manages nodes, tracks the current unique identifier (`nowID`), and defines the graph type (directed/undirected, weighted/unweighted).
 // The `updated` field indicates whether the graph has been modified since the last algorithmic computation.
+//
+// A Graph is safe for concurrent use: mutations take an internal write lock and reads take a read lock,
+// so nodes and edges can be added from one goroutine while algorithms read the graph from others.
+// Nodes returned by FindNode and FindNodesByName are shared with the graph and must not be modified concurrently.
 type Graph struct {
-	nodes     *graphNodes // A collection of all nodes in the graph.
-	nowID     NodeID      // The next unique identifier to be assigned to a new node.
-	graphType GraphType   // The type of the graph (e.g., directed, undirected, weighted, unweighted).
-	updated   bool        // Tracks if the graph has been modified since the last update.
-	edgeCount int         // Number of edges in graph.
+	mu        sync.RWMutex // Guards every other field, including the nodes and edges.
+	nodes     *graphNodes  // A collection of all nodes in the graph.
+	nowID     NodeID       // The next unique identifier to be assigned to a new node.
+	graphType GraphType    // The type of the graph (e.g., directed, undirected, weighted, unweighted).
+	updated   bool         // Tracks if the graph has been modified since the last update.
+	version   uint64       // Incremented on every modification.
+	edgeCount int          // Number of edges in graph.
+	readOnly  bool         // Whether the graph is a snapshot that rejects modifications.
+	shared    bool         // Whether the node table is shared with a snapshot.
+	epoch     uint64       // Nodes with an older epoch may be shared with a snapshot and are copied before modification.
+	options   Option       // The rules the graph enforces, fixed at creation.
+	nowEdgeID EdgeID       // The next unique identifier to be assigned to a new edge.
 }
 
 // NewGraph creates and initializes a new Graph instance.
@@ -23,16 +33,23 @@ type Graph struct {
 // Parameters:
 //   - graphType: The type of the graph (from the GraphType enumeration).
 //   - capacity: The initial capacity for the node collection.
+//   - options: Optional rules for the graph to enforce (see Option).
 //
 // Returns a pointer to the newly created Graph.
-func NewGraph(graphType GraphType, capacity int) *Graph {
-	return &Graph{
+func NewGraph(graphType GraphType, capacity int, options ...Option) *Graph {
+	g := &Graph{
 		nodes:     newNodes(capacity),
 		nowID:     0,
 		graphType: graphType,
 		updated:   false,
 		edgeCount: 0,
 	}
+
+	for _, option := range options {
+		g.options |= option
+	}
+
+	return g
 }
 
 // AddNode adds a new node to the graph with the given name.
@@ -40,9 +57,33 @@ func NewGraph(graphType GraphType, capacity int) *Graph {
 // Parameters:
 //   - name: The display name for the node.
 //
-// Returns the newly created Node and an error if insertion fails.
+// Returns the newly created Node and an error if insertion fails,
+// including when the graph has the UNIQUE_NAMES option and the name is already used.
 func (g *Graph) AddNode(name string) (*Node, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.addNode("AddNode", name)
+}
+
+// addNode validates and adds a node. The caller must hold the write lock.
+//
+// Parameters:
+//   - operation: The name of the public operation, used in errors.
+//   - name: The display name for the node.
+//
+// Returns the newly created Node and an error if insertion fails.
+func (g *Graph) addNode(operation, name string) (*Node, error) {
+	if err := g.writable(operation); err != nil {
+		return nil, err
+	}
+
+	if g.HasOption(UNIQUE_NAMES) && len(g.nodes.nameMap[name]) > 0 {
+		return nil, &AlreadyNodeError{ID: g.nodes.nameMap[name][0], Name: name}
+	}
+
 	node := newNode(g.nowID, name)
+	node.epoch = g.epoch
 	err := g.nodes.insert(node)
 
 	if err != nil {
@@ -51,22 +92,47 @@ func (g *Graph) AddNode(name string) (*Node, error) {
 
 	// Increment the unique identifier for the next node.
 	g.nowID++
-	g.updated = false // Mark the graph as modified.
+	g.modified() // Mark the graph as modified.
 
 	return node, nil
 }
 
-// RemoveNode removes a node from the graph using its identifier.
+// RemoveNode removes a node from the graph using its identifier, together with every edge leaving or entering it.
+// The identifier is not reused; see Compact to close the gaps left by removed nodes.
 //
 // Parameters:
 //   - identifier: The unique identifier of the node to remove.
 //
 // Returns an error if the node does not exist.
 func (g *Graph) RemoveNode(identifier NodeID) error {
-	g.updated = false // Mark the graph as modified.
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.writable("RemoveNode"); err != nil {
+		return err
+	}
+
+	node := g.nodes.find(identifier)
+
+	if node == nil {
+		return &NotExistNodeError{ID: identifier}
+	}
+
+	g.modified() // Mark the graph as modified.
+
+	// Iterate over a copy, since removing edges modifies the node's edge list.
+	for _, edge := range append([]*edge(nil), node.edges...) {
+		err := g.removeEdge(identifier, edge.to)
+
+		if err != nil {
+			return err
+		}
+	}
 
-	for _, edge := range g.nodes.find(identifier).edges {
-		err := g.RemoveEdge(identifier, edge.to)
+	// In directed graphs, edges entering the node are stored on their sources and must be removed too.
+	// The incoming-edge index lists those sources, so no other node has to be scanned.
+	for _, from := range append([]NodeID(nil), g.nodes.find(identifier).in...) {
+		err := g.removeEdge(from, identifier)
 
 		if err != nil {
 			return err
@@ -83,12 +149,15 @@ func (g *Graph) RemoveNode(identifier NodeID) error {
 //
 // Returns the Node and an error if the node does not exist.
 func (g *Graph) FindNode(identifier NodeID) (*Node, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
 	result := g.nodes.find(identifier)
 
 	if result != nil {
 		return result, nil
 	} else {
-		return nil, graph_err.NotExistNode(identifier.String())
+		return nil, &NotExistNodeError{ID: identifier}
 	}
 }
 
@@ -99,15 +168,53 @@ func (g *Graph) FindNode(identifier NodeID) (*Node, error) {
 //
 // Returns a slice of Nodes and an error if no nodes with the given name exist.
 func (g *Graph) FindNodesByName(name string) ([]*Node, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
 	result := g.nodes.findAll(name)
 
-	if result != nil {
+	if len(result) > 0 {
 		return result, nil
 	} else {
-		return nil, graph_err.NotExistNode(name)
+		return nil, &NotExistNodeError{Name: name}
 	}
 }
 
+// RenameNode changes the display name of a node and updates the name index used by FindNodesByName.
+//
+// Parameters:
+//   - identifier: The unique identifier of the node.
+//   - name: The new display name.
+//
+// Returns an error if the node does not exist,
+// or if the graph has the UNIQUE_NAMES option and another node already uses the name.
+func (g *Graph) RenameNode(identifier NodeID, name string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.writable("RenameNode"); err != nil {
+		return err
+	}
+
+	node := g.own(identifier)
+
+	if node == nil {
+		return &NotExistNodeError{ID: identifier}
+	}
+
+	if node.name == name {
+		return nil
+	}
+
+	if g.HasOption(UNIQUE_NAMES) && len(g.nodes.nameMap[name]) > 0 {
+		return &AlreadyNodeError{ID: g.nodes.nameMap[name][0], Name: name}
+	}
+
+	g.nodes.rename(node, name)
+
+	return nil
+}
+
 // AddEdge adds an unweighted edge between two nodes in the graph.
 //
 // Parameters:
@@ -124,54 +231,91 @@ func (g *Graph) AddEdge(from, to NodeID) error {
 // Parameters:
 //   - from: The identifier of the source node.
 //   - to: The identifier of the destination node.
-//   - distance: The weight of the edge.
+//   - distance: The weight of the edge. It must be finite, and non-negative unless the graph is DIRECTED_WEIGHTED.
 //
 // Returns an error if the edge cannot be added.
+// Unless the graph has the MULTIGRAPH option, an edge between the same nodes must not exist yet,
+// and unless it has the ALLOW_SELF_LOOPS option, `from` and `to` must differ.
 func (g *Graph) AddWeightEdge(from, to NodeID, distance Distance) error {
-	// Check for invalid edge types and self-loops.
-	if (g.graphType == DIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_UNWEIGHTED) && distance != 1 {
-		return graph_err.InvalidEdge(g.graphType.String(), fmt.Sprintf("weight: %d", distance))
-	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	_, err := g.insertEdge("AddWeightEdge", from, to, distance)
+	return err
+}
 
-	if from == to {
-		return graph_err.SelfEdge(from.String())
+// InsertEdge adds a weighted edge between two nodes like AddWeightEdge and returns its identifier.
+// The identifier tells parallel edges of a multigraph apart, for example in RemoveEdgeByID.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - distance: The weight of the edge. It must be finite, and non-negative unless the graph is DIRECTED_WEIGHTED.
+//
+// Returns the identifier of the new edge, and an error if the edge cannot be added.
+func (g *Graph) InsertEdge(from, to NodeID, distance Distance) (EdgeID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.insertEdge("InsertEdge", from, to, distance)
+}
+
+// insertEdge validates and adds a weighted edge. The caller must hold the write lock.
+//
+// Parameters:
+//   - operation: The name of the public operation, used in errors.
+//   - from, to, distance: See AddWeightEdge.
+//
+// Returns the identifier of the new edge, and an error if the edge cannot be added.
+func (g *Graph) insertEdge(operation string, from, to NodeID, distance Distance) (EdgeID, error) {
+	if err := g.writable(operation); err != nil {
+		return 0, err
 	}
 
-	f := g.nodes.find(from)
-	t := g.nodes.find(to)
+	// Check for invalid edge types, invalid weights and self-loops.
+	if (g.graphType == DIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_UNWEIGHTED) && distance != 1 {
+		return 0, &InvalidEdgeError{Type: g.graphType, Distance: distance}
+	}
 
-	// Ensure both nodes exist in the graph.
-	if f == nil {
-		return graph_err.NotExistNode(from.String())
+	if !g.validWeight(distance) {
+		return 0, &InvalidEdgeError{Type: g.graphType, Distance: distance}
 	}
-	if t == nil {
-		return graph_err.NotExistNode(to.String())
+
+	if err := g.validEndpoints(from, to); err != nil {
+		return 0, err
 	}
 
-	// Add the edge to the source node.
-	err := f.addEdge(to, distance)
+	id := g.nowEdgeID
+	parallel := g.HasOption(MULTIGRAPH)
+	f := g.own(from)
 
-	if err != nil {
-		return err
+	// Add the edge to the source node.
+	if _, err := f.addEdge(id, to, distance, parallel); err != nil {
+		return 0, err
 	}
 
-	// Add a reverse edge for undirected graphs.
-	if g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED {
-		err = t.addEdge(from, distance)
+	t := g.own(to)
+	t.addIncoming(from)
 
-		if err != nil {
-			return err
+	// Add a reverse edge for undirected graphs. A self-loop is stored once.
+	if (g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED) && from != to {
+		if _, err := t.addEdge(id, from, distance, parallel); err != nil {
+			return 0, err
 		}
+
+		f.addIncoming(to)
 	}
 
-	g.updated = false // Mark the graph as modified.
-	g.edgeCount++     // Update edge count
+	g.nowEdgeID++
+	g.modified()  // Mark the graph as modified.
+	g.edgeCount++ // Update edge count
 
-	return nil
+	return id, nil
 }
 
 // RemoveEdge removes an edge between two nodes in the graph.
 // For undirected graphs, the reverse edge is also removed.
+// In a multigraph, every parallel edge between the two nodes is removed; see RemoveEdgeByID to remove one of them.
 //
 // Parameters:
 //   - from: The identifier of the source node.
@@ -185,39 +329,129 @@ func (g *Graph) AddWeightEdge(from, to NodeID, distance Distance) error {
 //   - The graph's `updated` flag is set to false to indicate that modifications have been made.
 //   - For undirected graphs, the reverse edge (to -> from) is also removed.
 func (g *Graph) RemoveEdge(from, to NodeID) error {
-	if from == to {
-		return graph_err.SelfEdge(from.String())
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.writable("RemoveEdge"); err != nil {
+		return err
+	}
+
+	if err := g.removeEdge(from, to); err != nil {
+		return err
+	}
+
+	// Remove the remaining parallel edges of a multigraph.
+	for g.nodes.find(from).findEdge(to) != nil {
+		if err := g.removeEdge(from, to); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveEdgeByID removes one edge between two nodes, identified by its EdgeID.
+// For undirected graphs, the reverse edge is also removed, and the endpoints may be given in either order.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - id: The identifier of the edge, as returned by InsertEdge, FindEdges or Edges.
+//
+// Returns an error if the nodes or the edge do not exist.
+func (g *Graph) RemoveEdgeByID(from, to NodeID, id EdgeID) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.writable("RemoveEdgeByID"); err != nil {
+		return err
+	}
+
+	if err := g.validEndpoints(from, to); err != nil {
+		return err
+	}
+
+	if g.nodes.find(from).findEdgeByID(to, id) == nil {
+		return &NotExistEdgeError{From: from, To: to}
+	}
+
+	return g.removeEdgeByID(from, to, id)
+}
+
+// removeEdge removes the first edge between two nodes. The caller must hold the write lock.
+func (g *Graph) removeEdge(from, to NodeID) error {
+	if err := g.validEndpoints(from, to); err != nil {
+		return err
+	}
+
+	e := g.nodes.find(from).findEdge(to)
+
+	if e == nil {
+		return &NotExistEdgeError{From: from, To: to}
+	}
+
+	return g.removeEdgeByID(from, to, e.id)
+}
+
+// validWeight reports whether a distance can be the weight of an edge of the graph.
+// Only DIRECTED_WEIGHTED graphs accept negative weights, since an undirected negative edge is a negative cycle.
+func (g *Graph) validWeight(distance Distance) bool {
+	if g.graphType == DIRECTED_WEIGHTED {
+		return distance.IsFinite()
+	}
+
+	return distance.IsValid()
+}
+
+// validEndpoints checks that two nodes can be the endpoints of an edge.
+//
+// Returns an error if either does not exist, or if they are the same node and the graph does not allow self-loops.
+func (g *Graph) validEndpoints(from, to NodeID) error {
+	if from == to && !g.HasOption(ALLOW_SELF_LOOPS) {
+		return &SelfEdgeError{ID: from}
 	}
 
 	// Ensure both nodes exist in the graph.
 	if g.nodes.find(from) == nil {
-		return graph_err.NotExistNode(from.String())
+		return &NotExistNodeError{ID: from}
 	}
 	if g.nodes.find(to) == nil {
-		return graph_err.NotExistNode(to.String())
+		return &NotExistNodeError{ID: to}
 	}
 
-	err := g.nodes.find(from).removeEdge(to)
+	return nil
+}
+
+// removeEdgeByID removes an existing edge between two nodes. The caller must hold the write lock.
+func (g *Graph) removeEdgeByID(from, to NodeID, id EdgeID) error {
+	f := g.own(from)
+	err := f.removeEdge(to, id)
 
 	if err != nil {
 		return err
 	}
 
-	if g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED {
-		err = g.nodes.find(to).removeEdge(to)
+	t := g.own(to)
+	t.removeIncoming(from)
+
+	if (g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED) && from != to {
+		err = t.removeEdge(from, id)
 
 		if err != nil {
 			return err
 		}
+
+		f.removeIncoming(to)
 	}
 
-	g.updated = false // Mark the graph as modified.
-	g.edgeCount--     // Update edge count
+	g.modified()  // Mark the graph as modified.
+	g.edgeCount-- // Update edge count
 
 	return nil
 }
 
 // FindEdge searches for an edge between two nodes in the graph and returns its distance.
+// In a multigraph, only the edge added first is considered; use FindEdges to get every parallel edge.
 //
 // Parameters:
 //   - from: The identifier of the source node.
@@ -227,33 +461,69 @@ func (g *Graph) RemoveEdge(from, to NodeID) error {
 //   - A pointer to the `Distance` of the edge if it exists.
 //   - An error if the edge or either of the nodes does not exist, or if attempting to find a self-loop edge.
 func (g *Graph) FindEdge(from, to NodeID) (*Distance, error) {
-	if from == to {
-		return nil, graph_err.SelfEdge(from.String())
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if err := g.validEndpoints(from, to); err != nil {
+		return nil, err
 	}
 
-	f := g.nodes.find(from)
-	t := g.nodes.find(to)
+	for _, e := range g.nodes.find(from).edges {
+		if e.to == to {
+			// Return a copy, so the caller never reads the edge outside the lock.
+			distance := e.distance
+			return &distance, nil
+		}
+	}
 
-	// Ensure both nodes exist in the graph.
-	if f == nil {
-		return nil, graph_err.NotExistNode(from.String())
+	return nil, &NotExistEdgeError{From: from, To: to}
+}
+
+// FindEdges returns every edge between two nodes, which in a multigraph may be several parallel edges.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//
+// Returns:
+//   - The edges in ascending order of EdgeID, with `From` and `To` as given.
+//   - An error if no edge exists, if either of the nodes does not exist, or if attempting to find a self-loop edge.
+func (g *Graph) FindEdges(from, to NodeID) ([]Edge, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if err := g.validEndpoints(from, to); err != nil {
+		return nil, err
 	}
-	if t == nil {
-		return nil, graph_err.NotExistNode(to.String())
+
+	found := g.nodes.find(from).findEdges(to)
+
+	if len(found) == 0 {
+		return nil, &NotExistEdgeError{From: from, To: to}
 	}
 
-	for _, e := range f.edges {
-		if e.to == to {
-			return &e.distance, nil
-		}
+	result := make([]Edge, len(found))
+
+	for i, e := range found {
+		result[i] = Edge{ID: e.id, From: from, To: to, Distance: e.distance}
 	}
 
-	return nil, graph_err.NotExistEdge(from.String(), to.String())
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+
+	return result, nil
 }
 
 // Matrix converts the graph to an adjacency matrix representation.
 // Returns a Matrix where each element represents the distance between two nodes.
 func (g *Graph) Matrix() Matrix {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.matrix()
+}
+
+// matrix builds the adjacency matrix. The caller must hold the lock.
+func (g *Graph) matrix() Matrix {
 	size := g.nowID
 	matrix := make([][]Distance, size)
 
@@ -261,14 +531,16 @@ func (g *Graph) Matrix() Matrix {
 	for i := range matrix {
 		matrix[i] = make([]Distance, size)
 		for j := range matrix[i] {
-			matrix[i][j] = math.MaxUint
+			matrix[i][j] = INF
 		}
 	}
 
-	// Populate the matrix with edge distances.
+	// Populate the matrix with edge distances, keeping the shortest of parallel edges.
 	for from_id, from := range g.nodes.nodes {
 		for _, from_edge := range from.edges {
-			matrix[from_id][from_edge.to] = from_edge.distance
+			if from_edge.distance < matrix[from_id][from_edge.to] {
+				matrix[from_id][from_edge.to] = from_edge.distance
+			}
 		}
 	}
 
@@ -279,10 +551,13 @@ func (g *Graph) Matrix() Matrix {
 // This method formats the matrix for easy readability:
 //   - Each row of the matrix is printed on a new line.
 //   - Values are separated by spaces, with "INF" used for unreachable nodes.
-func (g Graph) String() string {
+func (g *Graph) String() string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
 	result := ""
 
-	matrix := g.Matrix()
+	matrix := g.matrix()
 
 	// Iterate over each row of the matrix.
 	for _, arr := range [][]Distance(matrix) {
@@ -290,7 +565,7 @@ func (g Graph) String() string {
 		for _, a := range arr {
 			if a != INF {
 				// Print the distance if it is not `INF`.
-				result += fmt.Sprintf("%3d ", a)
+				result += fmt.Sprintf("%3g ", a)
 			} else {
 				// Use "INF" to represent unreachable nodes.
 				result += "INF "
@@ -305,27 +580,57 @@ func (g Graph) String() string {
 }
 
 // NodeCount returns the number of nodes in the graph.
-func (g Graph) NodeCount() int {
+func (g *Graph) NodeCount() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
 	return len(g.nodes.nodes)
 }
 
 // EdgeCount returns the number of edges in the graph.
-func (g Graph) EdgeCount() int {
+func (g *Graph) EdgeCount() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
 	return g.edgeCount
 }
 
 // Type returns the type of the graph (e.g., directed/undirected, weighted/unweighted).
-func (g Graph) Type() GraphType {
+func (g *Graph) Type() GraphType {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
 	return g.graphType
 }
 
 // IsUpdated returns whether the graph has been updated since the last algorithmic computation.
-func (g Graph) IsUpdated() bool {
+func (g *Graph) IsUpdated() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
 	return g.updated
 }
 
+// Version returns a counter that is incremented on every modification of the graph.
+// Comparing versions tells whether the graph changed between two points in time.
+func (g *Graph) Version() uint64 {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.version
+}
+
+// modified records a modification of the graph. The caller must hold the write lock.
+func (g *Graph) modified() {
+	g.updated = false
+	g.version++
+}
+
 // Update sets the graph's updated status to true.
 // This should be called after performing an algorithmic computation.
 func (g *Graph) Update() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
 	g.updated = true
 }
@@ -0,0 +1,77 @@
+package graph
+
+// Index is a dense mapping between the identifiers of the nodes of a graph and the positions 0..n-1.
+// RemoveNode leaves gaps in the identifier space; an Index closes them, so per-node data can be stored in plain slices.
+// Positions follow the ascending order of the identifiers.
+//
+// Fields:
+//   - ids: The identifier of the node at each position.
+//   - positions: The position of each identifier.
+type Index struct {
+	ids       []NodeID       // The identifier of the node at each position, in ascending order.
+	positions map[NodeID]int // The position of each identifier.
+}
+
+// Index takes a dense index of the nodes currently in the graph.
+// The index is a snapshot and is not updated when nodes are added or removed later.
+//
+// Returns a pointer to the newly created Index.
+func (g *Graph) Index() *Index {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.index()
+}
+
+// index takes a dense index of the nodes. The caller must hold the lock.
+func (g *Graph) index() *Index {
+	ids := make([]NodeID, 0, len(g.nodes.nodes))
+
+	for id := range g.nodes.nodes {
+		ids = append(ids, id)
+	}
+
+	sortIDs(ids)
+
+	return newIndex(ids)
+}
+
+// newIndex creates an Index over identifiers that are already sorted in ascending order.
+func newIndex(ids []NodeID) *Index {
+	positions := make(map[NodeID]int, len(ids))
+
+	for i, id := range ids {
+		positions[id] = i
+	}
+
+	return &Index{ids: ids, positions: positions}
+}
+
+// Len returns the number of nodes in the index.
+func (x *Index) Len() int {
+	return len(x.ids)
+}
+
+// ID returns the identifier of the node at the given position.
+func (x *Index) ID(position int) NodeID {
+	return x.ids[position]
+}
+
+// Position returns the position of the node with the given identifier.
+//
+// Returns:
+//   - The position and true if the node is in the index, or -1 and false otherwise.
+func (x *Index) Position(identifier NodeID) (int, bool) {
+	position, ok := x.positions[identifier]
+
+	if !ok {
+		return -1, false
+	}
+
+	return position, true
+}
+
+// IDs returns the identifiers of all nodes in the index, in ascending order.
+func (x *Index) IDs() []NodeID {
+	return append([]NodeID(nil), x.ids...)
+}
@@ -1,29 +1,60 @@
 package graph_err
 
 import (
+	"errors"
 	"fmt"
 )
 
+// Sentinel errors wrapped by every error of the matching kind, for use with errors.Is.
+var (
+	ErrInvalidEdge       = errors.New("invalid edge for graph type")
+	ErrSelfEdge          = errors.New("node can not connect to self")
+	ErrAlreadyEdge       = errors.New("edge is already existed")
+	ErrNotExistEdge      = errors.New("edge not exist")
+	ErrAlreadyNode       = errors.New("node is already existed")
+	ErrNotExistNode      = errors.New("node not exist")
+	ErrNotExistAttribute = errors.New("attribute not exist")
+	ErrReadOnly          = errors.New("graph is read-only")
+	ErrTypeMismatch      = errors.New("graph types do not match")
+	ErrPayloadType       = errors.New("payload type does not match")
+)
+
 func InvalidEdge(graphKey, edgeKey string) error {
-	return fmt.Errorf("invalid edge for graph type: [(%s) does not fit %s]", edgeKey, graphKey)
+	return fmt.Errorf("%w: [(%s) does not fit %s]", ErrInvalidEdge, edgeKey, graphKey)
 }
 
 func SelfEdge(key string) error {
-	return fmt.Errorf("node can not connect to self: [%s]", key)
+	return fmt.Errorf("%w: [%s]", ErrSelfEdge, key)
 }
 
 func AlreadyEdge(fromKey, toKey string) error {
-	return fmt.Errorf("edge is already existed: [%s ---> %s]", fromKey, toKey)
+	return fmt.Errorf("%w: [%s ---> %s]", ErrAlreadyEdge, fromKey, toKey)
 }
 
 func NotExistEdge(fromKey, toKey string) error {
-	return fmt.Errorf("edge not exist: [%s ---> %s]", fromKey, toKey)
+	return fmt.Errorf("%w: [%s ---> %s]", ErrNotExistEdge, fromKey, toKey)
 }
 
 func AlreadyNode(key string) error {
-	return fmt.Errorf("node is already existed: [%s]", key)
+	return fmt.Errorf("%w: [%s]", ErrAlreadyNode, key)
 }
 
 func NotExistNode(key string) error {
-	return fmt.Errorf("node not exist: [%s]", key)
+	return fmt.Errorf("%w: [%s]", ErrNotExistNode, key)
+}
+
+func NotExistAttribute(ownerKey, attributeKey string) error {
+	return fmt.Errorf("%w: [%s of %s]", ErrNotExistAttribute, attributeKey, ownerKey)
+}
+
+func ReadOnly(operationKey string) error {
+	return fmt.Errorf("%w: [%s]", ErrReadOnly, operationKey)
+}
+
+func TypeMismatch(expectedKey, actualKey string) error {
+	return fmt.Errorf("%w: [%s and %s]", ErrTypeMismatch, expectedKey, actualKey)
+}
+
+func PayloadType(ownerKey, actualKey, expectedKey string) error {
+	return fmt.Errorf("%w: [%s holds %s, not %s]", ErrPayloadType, ownerKey, actualKey, expectedKey)
 }
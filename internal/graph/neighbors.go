@@ -0,0 +1,199 @@
+package graph
+
+import (
+	"sort"
+)
+
+// Edge describes an edge of a graph by its identifier, endpoints and distance.
+// For undirected graphs `From` is the smaller identifier.
+type Edge struct {
+	ID       EdgeID   // The identifier of the edge, which tells parallel edges apart.
+	From     NodeID   // The identifier of the source node.
+	To       NodeID   // The identifier of the destination node.
+	Distance Distance // The weight of the edge.
+}
+
+// Neighbors returns the nodes that a node has an edge to.
+// For undirected graphs these are all adjacent nodes.
+//
+// Parameters:
+//   - identifier: The unique identifier of the node.
+//
+// Returns the identifiers of the neighbors in ascending order, and an error if the node does not exist.
+// A neighbor connected by several parallel edges is listed once.
+func (g *Graph) Neighbors(identifier NodeID) ([]NodeID, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	node := g.nodes.find(identifier)
+
+	if node == nil {
+		return nil, &NotExistNodeError{ID: identifier}
+	}
+
+	result := make([]NodeID, 0, len(node.edges))
+
+	for _, e := range node.edges {
+		result = append(result, e.to)
+	}
+
+	return uniqueIDs(result), nil
+}
+
+// InNeighbors returns the nodes that have an edge to a node.
+// For undirected graphs the result equals Neighbors.
+//
+// Parameters:
+//   - identifier: The unique identifier of the node.
+//
+// Returns the identifiers of the neighbors in ascending order, and an error if the node does not exist.
+// A neighbor connected by several parallel edges is listed once.
+func (g *Graph) InNeighbors(identifier NodeID) ([]NodeID, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	node := g.nodes.find(identifier)
+
+	if node == nil {
+		return nil, &NotExistNodeError{ID: identifier}
+	}
+
+	// Read the incoming-edge index instead of scanning the edges of every node.
+	return uniqueIDs(append([]NodeID(nil), node.in...)), nil
+}
+
+// OutDegree returns the number of edges leaving a node.
+// For undirected graphs this is the number of edges touching the node; parallel edges are counted separately.
+// A self-loop adds 1 to both degrees of a directed graph and 2 to the degree of an undirected graph.
+//
+// Parameters:
+//   - identifier: The unique identifier of the node.
+//
+// Returns the degree and an error if the node does not exist.
+func (g *Graph) OutDegree(identifier NodeID) (int, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	node := g.nodes.find(identifier)
+
+	if node == nil {
+		return 0, &NotExistNodeError{ID: identifier}
+	}
+
+	return len(node.edges) + g.undirectedLoops(node), nil
+}
+
+// InDegree returns the number of edges entering a node; parallel edges are counted separately.
+// For undirected graphs the result equals OutDegree.
+//
+// Parameters:
+//   - identifier: The unique identifier of the node.
+//
+// Returns the degree and an error if the node does not exist.
+func (g *Graph) InDegree(identifier NodeID) (int, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	node := g.nodes.find(identifier)
+
+	if node == nil {
+		return 0, &NotExistNodeError{ID: identifier}
+	}
+
+	return len(node.in) + g.undirectedLoops(node), nil
+}
+
+// undirectedLoops returns the number of self-loops on a node of an undirected graph, which count twice in its degree.
+// In directed graphs it returns 0. The caller must hold the lock.
+func (g *Graph) undirectedLoops(node *Node) int {
+	if g.graphType == DIRECTED_UNWEIGHTED || g.graphType == DIRECTED_WEIGHTED {
+		return 0
+	}
+
+	return len(node.findEdges(node.identifier))
+}
+
+// Edges returns an iterator over all edges of the graph, ordered by source, destination and then EdgeID.
+// Every undirected edge is visited once, with the smaller identifier as `From`,
+// and every parallel edge of a multigraph is visited separately.
+// The edges are collected when Edges is called, so the graph may be modified while iterating.
+//
+// Returns a function that calls `yield` for every edge until it returns false.
+// With Go 1.23 or later, it can be used directly in a for-range loop.
+func (g *Graph) Edges() func(yield func(Edge) bool) {
+	g.mu.RLock()
+	edges := make([]Edge, 0, g.edgeCount)
+	undirected := g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED
+
+	for from, node := range g.nodes.nodes {
+		for _, e := range node.edges {
+			if !undirected || from <= e.to {
+				edges = append(edges, Edge{ID: e.id, From: from, To: e.to, Distance: e.distance})
+			}
+		}
+	}
+	g.mu.RUnlock()
+
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+
+		if edges[i].To != edges[j].To {
+			return edges[i].To < edges[j].To
+		}
+
+		return edges[i].ID < edges[j].ID
+	})
+
+	return func(yield func(Edge) bool) {
+		for _, e := range edges {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Nodes returns an iterator over all nodes of the graph in ascending order of identifier.
+// The nodes are collected when Nodes is called, so the graph may be modified while iterating.
+//
+// Returns a function that calls `yield` for every node until it returns false.
+// With Go 1.23 or later, it can be used directly in a for-range loop.
+func (g *Graph) Nodes() func(yield func(*Node) bool) {
+	g.mu.RLock()
+	index := g.index()
+	nodes := make([]*Node, index.Len())
+
+	for i, id := range index.ids {
+		nodes[i] = g.nodes.nodes[id]
+	}
+	g.mu.RUnlock()
+
+	return func(yield func(*Node) bool) {
+		for _, node := range nodes {
+			if !yield(node) {
+				return
+			}
+		}
+	}
+}
+
+// sortIDs sorts node identifiers in ascending order.
+func sortIDs(ids []NodeID) {
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+}
+
+// uniqueIDs sorts node identifiers in ascending order and removes duplicates.
+func uniqueIDs(ids []NodeID) []NodeID {
+	sortIDs(ids)
+	result := ids[:0]
+
+	for _, id := range ids {
+		if len(result) == 0 || id != result[len(result)-1] {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
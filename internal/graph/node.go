@@ -1,16 +1,17 @@
 package graph
 
-import (
-	"github.com/elecbug/go-netrics/internal/graph/internal/graph_err" // Custom error package
-)
-
 // Node represents a node in the graph.
-// It contains a unique identifier (`identifier`), a display name (`Name`),
-// the edges connected to the node (`edges`), and a flag (`alive`) indicating whether the node is active.
+// It contains a unique identifier (`identifier`), a display name (`name`),
+// the edges connected to the node (`edges`), the sources of the edges entering it (`in`),
+// user-defined properties (`attributes`) and user-defined data (`payload`).
 type Node struct {
-	identifier NodeID  // Unique identifier for the node.
-	Name       string  // A human-readable name for the node, which can be duplicated across nodes.
-	edges      []*edge // A list of edges originating from this node.
+	identifier NodeID     // Unique identifier for the node.
+	name       string     // A human-readable name for the node, which can be duplicated across nodes.
+	edges      []*edge    // A list of edges originating from this node.
+	in         []NodeID   // The identifiers of the nodes with an edge to this node.
+	attributes Attributes // User-defined properties of the node.
+	payload    any        // User-defined data of the node, set through TypedGraph.
+	epoch      uint64     // The graph epoch in which this instance was created, used for copy-on-write.
 }
 
 // newNode creates a new Node instance.
@@ -23,33 +24,38 @@ type Node struct {
 func newNode(identifier NodeID, name string) *Node {
 	return &Node{
 		identifier: identifier,
-		Name:       name,
+		name:       name,
 		edges:      make([]*edge, 0), // Initialize the edges list as empty.
+		in:         make([]NodeID, 0),
 	}
 }
 
 // addEdge adds a new edge to the node's list of edges.
 //
 // Parameters:
+//   - id: The identifier of the edge.
 //   - to: The identifier of the destination node.
 //   - distance: The weight of the edge.
-func (n *Node) addEdge(to NodeID, distance Distance) error {
+//   - parallel: Whether an edge to the same destination may already exist, as in a multigraph.
+//
+// Returns the new edge, or an error if an edge to `to` already exists and `parallel` is false.
+func (n *Node) addEdge(id EdgeID, to NodeID, distance Distance, parallel bool) (*edge, error) {
 	// Prevent duplicate edges.
-	for _, e := range n.edges {
-		if e.to == to {
-			return graph_err.AlreadyEdge(n.identifier.String(), to.String())
-		}
+	if !parallel && n.findEdge(to) != nil {
+		return nil, &AlreadyEdgeError{From: n.identifier, To: to}
 	}
 
-	n.edges = append(n.edges, newEdge(to, distance))
+	e := newEdge(id, to, distance)
+	n.edges = append(n.edges, e)
 
-	return nil
+	return e, nil
 }
 
 // removeEdge removes an edge from the node's list of edges that points to the specified destination node.
 //
 // Parameters:
 //   - to: The identifier of the destination node whose edge needs to be removed.
+//   - id: The identifier of the edge, which tells parallel edges apart.
 //
 // Returns:
 //   - nil if the edge is successfully removed.
@@ -57,9 +63,9 @@ func (n *Node) addEdge(to NodeID, distance Distance) error {
 //
 // Notes:
 //   - If the specified edge is found, it is removed, and the node's edge list is updated.
-func (n *Node) removeEdge(to NodeID) error {
+func (n *Node) removeEdge(to NodeID, id EdgeID) error {
 	for i, e := range n.edges {
-		if e.to == to {
+		if e.to == to && e.id == id {
 			// Remove the edge by slicing the edge list.
 			n.edges = append(n.edges[:i], n.edges[i+1:]...)
 
@@ -68,7 +74,40 @@ func (n *Node) removeEdge(to NodeID) error {
 	}
 
 	// Return an error if the specified edge does not exist.
-	return graph_err.NotExistEdge(n.identifier.String(), to.String())
+	return &NotExistEdgeError{From: n.identifier, To: to}
+}
+
+// addIncoming records that the node with the given identifier has an edge to this node.
+func (n *Node) addIncoming(from NodeID) {
+	n.in = append(n.in, from)
+}
+
+// removeIncoming removes the record of an edge from the node with the given identifier to this node.
+func (n *Node) removeIncoming(from NodeID) {
+	for i, id := range n.in {
+		if id == from {
+			n.in = append(n.in[:i], n.in[i+1:]...)
+			return
+		}
+	}
+}
+
+// clone returns a copy of the node with its own edges and attributes.
+func (n *Node) clone() *Node {
+	result := &Node{
+		identifier: n.identifier,
+		name:       n.name,
+		edges:      make([]*edge, len(n.edges)),
+		in:         append([]NodeID(nil), n.in...),
+		attributes: n.attributes.clone(),
+		payload:    n.payload,
+	}
+
+	for i, e := range n.edges {
+		result.edges[i] = &edge{id: e.id, to: e.to, distance: e.distance, attributes: e.attributes.clone(), payload: e.payload}
+	}
+
+	return result
 }
 
 // ID returns the unique identifier of the node.
@@ -77,23 +116,101 @@ func (n Node) ID() NodeID {
 	return n.identifier
 }
 
+// Name returns the display name of the node.
+// Names can only be changed with Graph.RenameNode, which keeps the name index of the graph consistent.
+func (n Node) Name() string {
+	return n.name
+}
+
+// Payload returns the user-defined data of the node, or nil if it has none.
+// TypedGraph.NodePayload returns the same value with its static type.
+func (n Node) Payload() any {
+	return n.payload
+}
+
+// Attributes returns a copy of the user-defined properties of the node.
+// Modifying the returned map does not affect the graph; use Graph.SetNodeAttribute instead.
+func (n Node) Attributes() Attributes {
+	return n.attributes.clone()
+}
+
+// findEdge returns the edge from this node to the specified destination node, or nil if it does not exist.
+// Among parallel edges, the one added first is returned.
+func (n *Node) findEdge(to NodeID) *edge {
+	for _, e := range n.edges {
+		if e.to == to {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// findEdgeByID returns the edge with the given identifier from this node to the specified destination node,
+// or nil if it does not exist.
+func (n *Node) findEdgeByID(to NodeID, id EdgeID) *edge {
+	for _, e := range n.edges {
+		if e.to == to && e.id == id {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// findEdges returns every edge from this node to the specified destination node, in the order they were added.
+func (n *Node) findEdges(to NodeID) []*edge {
+	result := []*edge{}
+
+	for _, e := range n.edges {
+		if e.to == to {
+			result = append(result, e)
+		}
+	}
+
+	return result
+}
+
 // edge represents a connection (edge) between two nodes in a graph.
-// It contains information about the destination node (`to`) and the weight of the edge (`distance`).
+// It contains its identifier (`id`), the destination node (`to`), the weight of the edge (`distance`),
+// user-defined properties (`attributes`) and user-defined data (`payload`).
 type edge struct {
-	to       NodeID   // The destination node's unique identifier.
-	distance Distance // The weight or cost of traveling along this edge.
+	id         EdgeID     // The edge's unique identifier, shared by both stored directions of an undirected edge.
+	to         NodeID     // The destination node's unique identifier.
+	distance   Distance   // The weight or cost of traveling along this edge.
+	attributes Attributes // User-defined properties of the edge.
+	payload    any        // User-defined data of the edge, set through TypedGraph.
 }
 
 // newEdge creates a new Edge instance.
 //
 // Parameters:
+//   - id: The edge's identifier.
 //   - to: The destination node's identifier.
 //   - distance: The weight of the edge.
 //
 // Returns a pointer to the newly created Edge.
-func newEdge(to NodeID, distance Distance) *edge {
+func newEdge(id EdgeID, to NodeID, distance Distance) *edge {
 	return &edge{
+		id:       id,
 		to:       to,
 		distance: distance,
 	}
 }
+
+// weight returns the weight of the edge, read from the property `key` if it holds a valid numeric value.
+//
+// Parameters:
+//   - key: The name of the edge property to use as the weight. An empty key selects the distance.
+//   - signed: Whether a negative property value is valid, as in DIRECTED_WEIGHTED graphs.
+//
+// Returns the weight of the edge.
+func (e *edge) weight(key string, signed bool) Distance {
+	if key != "" {
+		if value, ok := e.attributes.Float(key); ok && (Distance(value).IsValid() || signed && Distance(value).IsFinite()) {
+			return Distance(value)
+		}
+	}
+
+	return e.distance
+}
@@ -1,9 +1,5 @@
 package graph
 
-import (
-	"github.com/elecbug/go-netrics/internal/graph/internal/graph_err" // Custom error package
-)
-
 // graphNodes represents a collection of nodes in a graph.
 // It maintains two mappings:
 //  1. `nodes`: Maps a node's unique identifier to its corresponding Node object.
@@ -35,18 +31,18 @@ func newNodes(cap int) *graphNodes {
 func (ns *graphNodes) insert(node *Node) error {
 	if _, exists := ns.nodes[node.ID()]; exists {
 		// Return an error if the node identifier already exists in the collection.
-		return graph_err.AlreadyNode(node.ID().String())
+		return &AlreadyNodeError{ID: node.ID()}
 	} else {
 		// Add the node to the nodes map.
 		ns.nodes[node.ID()] = node
 
 		// Initialize the nameMap entry if it doesn't exist.
-		if ns.nameMap[node.Name] == nil {
-			ns.nameMap[node.Name] = make([]NodeID, 0)
+		if ns.nameMap[node.name] == nil {
+			ns.nameMap[node.name] = make([]NodeID, 0)
 		}
 
 		// Add the node's identifier to the nameMap.
-		ns.nameMap[node.Name] = append(ns.nameMap[node.Name], node.ID())
+		ns.nameMap[node.name] = append(ns.nameMap[node.name], node.ID())
 
 		return nil
 	}
@@ -61,7 +57,7 @@ func (ns *graphNodes) insert(node *Node) error {
 func (ns *graphNodes) remove(identifier NodeID) error {
 	if _, exists := ns.nodes[identifier]; exists {
 		// Retrieve the node's name for nameMap cleanup.
-		name := ns.nodes[identifier].Name
+		name := ns.nodes[identifier].name
 
 		// Remove the node from the nodes map.
 		delete(ns.nodes, identifier)
@@ -77,8 +73,49 @@ func (ns *graphNodes) remove(identifier NodeID) error {
 		return nil
 	} else {
 		// Return an error if the node identifier does not exist.
-		return graph_err.NotExistNode(identifier.String())
+		return &NotExistNodeError{ID: identifier}
+	}
+}
+
+// rename changes the name of a Node of the collection and moves its identifier in the name index.
+//
+// Parameters:
+//   - node: The Node to rename, which must belong to the collection.
+//   - name: The new name of the Node.
+func (ns *graphNodes) rename(node *Node, name string) {
+	ids := ns.nameMap[node.name]
+
+	for i := 0; i < len(ids); i++ {
+		if ids[i] == node.ID() {
+			ns.nameMap[node.name] = append(ids[:i], ids[i+1:]...)
+			break
+		}
+	}
+
+	if len(ns.nameMap[node.name]) == 0 {
+		delete(ns.nameMap, node.name)
 	}
+
+	node.name = name
+	ns.nameMap[name] = append(ns.nameMap[name], node.ID())
+}
+
+// clone returns a copy of the collection that shares the Node instances but not the maps.
+func (ns *graphNodes) clone() *graphNodes {
+	result := &graphNodes{
+		nodes:   make(map[NodeID]*Node, len(ns.nodes)),
+		nameMap: make(map[string][]NodeID, len(ns.nameMap)),
+	}
+
+	for id, node := range ns.nodes {
+		result.nodes[id] = node
+	}
+
+	for name, ids := range ns.nameMap {
+		result.nameMap[name] = append([]NodeID(nil), ids...)
+	}
+
+	return result
 }
 
 // find retrieves a Node by its identifier.
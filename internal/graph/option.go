@@ -0,0 +1,37 @@
+package graph
+
+// Option is a set of flags that change the rules a graph enforces. Options are fixed when the graph is created.
+type Option int
+
+// Enumeration values for Option. Several options can be combined by passing them together to NewGraph.
+const (
+	UNIQUE_NAMES     Option = 1 << iota // Reject nodes whose name is already used by another node.
+	MULTIGRAPH                          // Allow several parallel edges between the same pair of nodes.
+	ALLOW_SELF_LOOPS                    // Allow edges from a node to itself.
+)
+
+// String converts an Option value to its string representation.
+func (o Option) String() string {
+	switch o {
+	case 0:
+		return "No Options"
+	case UNIQUE_NAMES:
+		return "Unique Names"
+	case MULTIGRAPH:
+		return "Multigraph"
+	case ALLOW_SELF_LOOPS:
+		return "Self-Loops Allowed"
+	default:
+		return "Combined Options"
+	}
+}
+
+// HasOption reports whether the graph was created with the given option.
+//
+// Parameters:
+//   - option: The option to check.
+//
+// Returns true if every flag of `option` is set.
+func (g *Graph) HasOption(option Option) bool {
+	return g.options&option == option
+}
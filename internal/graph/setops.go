@@ -0,0 +1,396 @@
+package graph
+
+import "unsafe"
+
+// NodePair identifies a node of a product graph by the nodes of the two graphs it was made from.
+type NodePair struct {
+	First  NodeID // The identifier of the node in the receiving graph.
+	Second NodeID // The identifier of the node in the other graph.
+}
+
+// Union returns a graph with the nodes and edges of both graphs, where matching nodes are merged.
+// The nodes of this graph keep their identifiers; the unmatched nodes of the other graph are appended.
+// An edge present in both graphs becomes a single edge whose distance is combined with `merge`.
+//
+// Parameters:
+//   - other: The graph to combine with. It must have the same type.
+//   - mapping: The node of this graph that every node of the other graph corresponds to.
+//     If nil, nodes are matched by name (see Notes).
+//   - merge: How the distances of matching edges are combined. Ignored for unweighted graphs.
+//
+// Returns:
+//   - The union graph.
+//   - The identifier in the union graph of every node of the other graph.
+//   - An error if the types differ, if the mapping refers to nodes that do not exist,
+//     or if merging the distances of matching edges overflows.
+//
+// Notes:
+//   - When matching by name, the k-th node with a given name in one graph, in ascending order of identifiers,
+//     matches the k-th node with that name in the other graph.
+//   - For matching nodes and edges, the attributes of this graph take precedence over the ones of the other graph.
+//   - The union keeps the options of this graph, except UNIQUE_NAMES if an unmatched node reuses a name.
+//   - Both graphs are read-locked for the duration of the operation, so neither is copied or marked as shared.
+func (g *Graph) Union(other *Graph, mapping IDMap, merge WeightMerge) (*Graph, IDMap, error) {
+	defer g.readLock(other)()
+
+	matching, err := g.match(other, mapping)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return g.union(other, matching, merge)
+}
+
+// DisjointUnion returns a graph with the nodes and edges of both graphs, side by side.
+// The nodes of this graph keep their identifiers; the nodes of the other graph are appended.
+//
+// Parameters:
+//   - other: The graph to combine with. It must have the same type.
+//
+// Returns:
+//   - The disjoint union graph. It keeps the options of this graph, except UNIQUE_NAMES if a name is used in both graphs.
+//   - The identifier in the disjoint union graph of every node of the other graph.
+//   - An error if the types differ.
+func (g *Graph) DisjointUnion(other *Graph) (*Graph, IDMap, error) {
+	defer g.readLock(other)()
+
+	if err := g.compatible(other); err != nil {
+		return nil, nil, err
+	}
+
+	return g.union(other, IDMap{}, MERGE_MIN)
+}
+
+// Intersection returns a graph with the matching nodes of both graphs and the edges present in both.
+// Nodes keep their identifiers in this graph; distances of the common edges are combined with `merge`.
+//
+// Parameters:
+//   - other: The graph to combine with. It must have the same type.
+//   - mapping: The node of this graph that every node of the other graph corresponds to.
+//     If nil, nodes are matched by name as in Union.
+//   - merge: How the distances of matching edges are combined. Ignored for unweighted graphs.
+//
+// Returns:
+//   - The intersection graph.
+//   - An error if the types differ, if the mapping refers to nodes that do not exist,
+//     or if merging the distances of matching edges overflows.
+func (g *Graph) Intersection(other *Graph, mapping IDMap, merge WeightMerge) (*Graph, error) {
+	defer g.readLock(other)()
+
+	matching, err := g.match(other, mapping)
+
+	if err != nil {
+		return nil, err
+	}
+
+	shared := make(IDMap, len(matching))
+
+	for _, mine := range matching {
+		shared[mine] = mine
+	}
+
+	result := g.replicate(g.graphType, shared.sortedKeys())
+	common := g.matchedEdges(other, matching)
+
+	g.eachEdge(func(from NodeID, e *edge) {
+		theirs, ok := common[NewEdgeKey(from, e.to, g.isDirected())]
+
+		if ok && err == nil {
+			// Parallel edges of a multigraph are all kept, and the matching edge is merged into the first of them.
+			first := result.nodes.find(from).findEdge(e.to) == nil
+			result.appendEdge(from, e.to, e.distance, e.attributes, e.payload)
+
+			if first {
+				err = result.mergeEdge(from, e.to, theirs.distance, theirs.attributes, theirs.payload, merge)
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Difference returns a graph with all nodes of this graph and the edges that the other graph does not have.
+// Nodes keep their identifiers; distances and attributes are copied from this graph.
+//
+// Parameters:
+//   - other: The graph to subtract. It must have the same type.
+//   - mapping: The node of this graph that every node of the other graph corresponds to.
+//     If nil, nodes are matched by name as in Union.
+//
+// Returns:
+//   - The difference graph.
+//   - An error if the types differ or if the mapping refers to nodes that do not exist.
+func (g *Graph) Difference(other *Graph, mapping IDMap) (*Graph, error) {
+	defer g.readLock(other)()
+
+	matching, err := g.match(other, mapping)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := g.replicate(g.graphType, g.index().ids)
+	common := g.matchedEdges(other, matching)
+
+	g.eachEdge(func(from NodeID, e *edge) {
+		if _, ok := common[NewEdgeKey(from, e.to, g.isDirected())]; !ok {
+			result.appendEdge(from, e.to, e.distance, e.attributes, e.payload)
+		}
+	})
+
+	return result, nil
+}
+
+// CartesianProduct returns the Cartesian product of two graphs.
+// It has a node for every pair of nodes (a, b), and (a, b) is adjacent to (c, d)
+// if a = c and b is adjacent to d in the other graph, or if b = d and a is adjacent to c in this graph.
+//
+// Parameters:
+//   - other: The second factor. It must have the same type.
+//
+// Returns:
+//   - The product graph, of the same type. Every node is named "(a, b)" after the names of its pair,
+//     and every edge copies the distance and attributes of the factor edge it comes from.
+//     It allows parallel edges and self-loops if either factor does.
+//   - The pair of nodes that every node of the product stands for, indexed by identifier.
+//     Pairs are numbered in ascending order of the node of this graph, then of the other graph.
+//   - An error if the types differ.
+func (g *Graph) CartesianProduct(other *Graph) (*Graph, []NodePair, error) {
+	defer g.readLock(other)()
+
+	if err := g.compatible(other); err != nil {
+		return nil, nil, err
+	}
+
+	first, second := g.index(), other.index()
+	result := NewGraph(g.graphType, first.Len()*second.Len(), (g.options|other.options)&(MULTIGRAPH|ALLOW_SELF_LOOPS))
+	pairs := make([]NodePair, 0, first.Len()*second.Len())
+
+	position := func(a, b NodeID) NodeID {
+		i, _ := first.Position(a)
+		j, _ := second.Position(b)
+
+		return NodeID(i*second.Len() + j)
+	}
+
+	for _, a := range first.ids {
+		for _, b := range second.ids {
+			result.appendNode("("+g.nodes.find(a).name+", "+other.nodes.find(b).name+")", nil, nil)
+			pairs = append(pairs, NodePair{First: a, Second: b})
+		}
+	}
+
+	g.eachEdge(func(from NodeID, e *edge) {
+		for _, b := range second.ids {
+			result.appendEdge(position(from, b), position(e.to, b), e.distance, e.attributes, e.payload)
+		}
+	})
+
+	other.eachEdge(func(from NodeID, e *edge) {
+		for _, a := range first.ids {
+			result.appendEdge(position(a, from), position(a, e.to), e.distance, e.attributes, e.payload)
+		}
+	})
+
+	return result, pairs, nil
+}
+
+// union builds the union of this graph and another one. The caller must hold the read locks of both.
+//
+// Parameters:
+//   - o: The other graph.
+//   - matching: The node of this graph that matching nodes of the other graph correspond to.
+//   - merge: How the distances of matching edges are combined.
+//
+// Returns:
+//   - The union graph.
+//   - The identifier in the union graph of every node of the other graph.
+//   - An *InvalidEdgeError if merging the distances of matching edges overflows.
+func (g *Graph) union(o *Graph, matching IDMap, merge WeightMerge) (*Graph, IDMap, error) {
+	result := g.replicate(g.graphType, g.index().ids)
+	translation := make(IDMap, len(o.nodes.nodes))
+
+	for _, id := range o.index().ids {
+		node := o.nodes.find(id)
+
+		mine, ok := matching[id]
+
+		if !ok {
+			translation[id] = result.appendNode(node.name, node.attributes, node.payload).identifier
+
+			// Unmatched nodes may reuse a name, in which case the names are no longer unique.
+			if len(result.nodes.nameMap[node.name]) > 1 {
+				result.options &^= UNIQUE_NAMES
+			}
+
+			continue
+		}
+
+		translation[id] = mine
+		copied := result.nodes.find(mine)
+
+		for key, value := range node.attributes {
+			if _, exists := copied.attributes[key]; !exists {
+				if copied.attributes == nil {
+					copied.attributes = Attributes{}
+				}
+
+				copied.attributes[key] = value
+			}
+		}
+
+		if copied.payload == nil {
+			copied.payload = node.payload
+		}
+	}
+
+	g.eachEdge(func(from NodeID, e *edge) {
+		result.appendEdge(from, e.to, e.distance, e.attributes, e.payload)
+	})
+
+	var err error
+
+	o.eachEdge(func(from NodeID, e *edge) {
+		if err == nil && (translation[from] != translation[e.to] || result.HasOption(ALLOW_SELF_LOOPS)) {
+			err = result.mergeEdge(translation[from], translation[e.to], e.distance, e.attributes, e.payload, merge)
+		}
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, translation, nil
+}
+
+// match checks that another graph can be combined with this one and resolves which nodes correspond.
+// The caller must hold the read locks of both graphs.
+//
+// Parameters:
+//   - o: The other graph.
+//   - mapping: The node of this graph that every node of the other graph corresponds to, or nil to match by name.
+//
+// Returns:
+//   - The node of this graph that every matching node of the other graph corresponds to.
+//   - An error if the types differ or if the mapping refers to nodes that do not exist.
+func (g *Graph) match(o *Graph, mapping IDMap) (IDMap, error) {
+	if err := g.compatible(o); err != nil {
+		return nil, err
+	}
+
+	result := make(IDMap)
+
+	if mapping != nil {
+		for theirs, mine := range mapping {
+			if o.nodes.find(theirs) == nil {
+				return nil, &NotExistNodeError{ID: theirs}
+			}
+			if g.nodes.find(mine) == nil {
+				return nil, &NotExistNodeError{ID: mine}
+			}
+
+			result[theirs] = mine
+		}
+
+		return result, nil
+	}
+
+	for name, ids := range o.nodes.nameMap {
+		theirs := append([]NodeID(nil), ids...)
+		mine := append([]NodeID(nil), g.nodes.nameMap[name]...)
+		sortIDs(theirs)
+		sortIDs(mine)
+
+		for i := 0; i < len(theirs) && i < len(mine); i++ {
+			result[theirs[i]] = mine[i]
+		}
+	}
+
+	return result, nil
+}
+
+// compatible checks that another graph has the same type as this one.
+//
+// Returns an error if the types differ.
+func (g *Graph) compatible(o *Graph) error {
+	if g.graphType != o.graphType {
+		return &TypeMismatchError{Expected: g.graphType, Actual: o.graphType}
+	}
+
+	return nil
+}
+
+// matchedEdges returns the edges of another graph whose endpoints both match nodes of this graph,
+// keyed by the matching nodes. The caller must hold the read locks of both graphs.
+//
+// Parameters:
+//   - o: The other graph.
+//   - matching: The node of this graph that matching nodes of the other graph correspond to.
+//
+// Returns the edges keyed as edges of this graph; undirected keys have From < To.
+func (g *Graph) matchedEdges(o *Graph, matching IDMap) map[EdgeKey]*edge {
+	result := make(map[EdgeKey]*edge)
+
+	o.eachEdge(func(from NodeID, e *edge) {
+		a, okFrom := matching[from]
+		b, okTo := matching[e.to]
+
+		if okFrom && okTo && (a != b || g.HasOption(ALLOW_SELF_LOOPS)) {
+			key := NewEdgeKey(a, b, g.isDirected())
+
+			if _, exists := result[key]; !exists {
+				result[key] = e
+			}
+		}
+	})
+
+	return result
+}
+
+// eachEdge calls a function for every edge in ascending order of the source node.
+// Undirected edges are visited once, from the smaller identifier. The caller must hold the lock.
+func (g *Graph) eachEdge(visit func(from NodeID, e *edge)) {
+	directed := g.isDirected()
+
+	for _, id := range g.index().ids {
+		for _, e := range g.nodes.find(id).edges {
+			if directed || id <= e.to {
+				visit(id, e)
+			}
+		}
+	}
+}
+
+// isDirected reports whether the edges of the graph have a direction.
+func (g *Graph) isDirected() bool {
+	return g.graphType == DIRECTED_UNWEIGHTED || g.graphType == DIRECTED_WEIGHTED
+}
+
+// readLock read-locks this graph and another one until the returned function is called.
+// The locks are taken in the order of the graphs' addresses, so two operations that lock the same graphs
+// in opposite roles cannot deadlock behind a waiting writer; a graph combined with itself is locked once.
+func (g *Graph) readLock(other *Graph) func() {
+	if g == other {
+		g.mu.RLock()
+		return g.mu.RUnlock
+	}
+
+	first, second := g, other
+
+	if uintptr(unsafe.Pointer(second)) < uintptr(unsafe.Pointer(first)) {
+		first, second = second, first
+	}
+
+	first.mu.RLock()
+	second.mu.RLock()
+
+	return func() {
+		second.mu.RUnlock()
+		first.mu.RUnlock()
+	}
+}
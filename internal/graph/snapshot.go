@@ -0,0 +1,105 @@
+package graph
+
+// Snapshot returns a read-only view of the graph as it is now.
+// The snapshot can be passed to algorithms while the graph keeps changing; later modifications are not visible in it.
+//
+// Returns a pointer to the snapshot. Taking a snapshot of a snapshot returns the snapshot itself.
+//
+// Notes:
+//   - Taking a snapshot does not copy the graph. The snapshot shares the nodes with the graph,
+//     and the graph copies its node table and each node the first time they are modified afterwards.
+//   - Every mutator of the snapshot returns an error.
+func (g *Graph) Snapshot() *Graph {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.readOnly {
+		return g
+	}
+
+	// Every node existing now is shared from here on, until the graph copies it.
+	g.shared = true
+	g.epoch++
+
+	return &Graph{
+		nodes:     g.nodes,
+		nowID:     g.nowID,
+		nowEdgeID: g.nowEdgeID,
+		graphType: g.graphType,
+		updated:   g.updated,
+		version:   g.version,
+		edgeCount: g.edgeCount,
+		readOnly:  true,
+		options:   g.options,
+	}
+}
+
+// IsReadOnly reports whether the graph is a snapshot that cannot be modified.
+func (g *Graph) IsReadOnly() bool {
+	return g.readOnly
+}
+
+// writable prepares the graph for a modification. The caller must hold the write lock.
+// If the node table is shared with a snapshot, it is copied first.
+//
+// Parameters:
+//   - operation: The name of the modifying operation, used in the error.
+//
+// Returns an error if the graph is read-only.
+func (g *Graph) writable(operation string) error {
+	if g.readOnly {
+		return &ReadOnlyError{Operation: operation}
+	}
+
+	if g.shared {
+		g.nodes = g.nodes.clone()
+		g.shared = false
+	}
+
+	return nil
+}
+
+// own returns the node with the given identifier, ready to be modified. The caller must hold the write lock
+// and must have called writable. A node that may be shared with a snapshot is replaced by a copy first.
+//
+// Parameters:
+//   - identifier: The unique identifier of the node.
+//
+// Returns the node, or nil if it does not exist.
+func (g *Graph) own(identifier NodeID) *Node {
+	node := g.nodes.find(identifier)
+
+	if node == nil || node.epoch == g.epoch {
+		return node
+	}
+
+	node = node.clone()
+	node.epoch = g.epoch
+	g.nodes.nodes[identifier] = node
+
+	return node
+}
+
+// ownEdgePair finds the edge between two nodes like findEdgePair, owning both nodes first
+// so that the returned edges can be modified.
+func (g *Graph) ownEdgePair(from, to NodeID) ([]*edge, error) {
+	g.own(from)
+
+	if g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED {
+		g.own(to)
+	}
+
+	return g.findEdgePair(from, to)
+}
+
+// ownEdgePairByID finds one edge between two nodes like findEdgePairByID, owning both nodes first
+// so that the returned edges can be modified.
+func (g *Graph) ownEdgePairByID(from, to NodeID, id EdgeID) ([]*edge, error) {
+	g.own(from)
+
+	if g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED {
+		g.own(to)
+	}
+
+	return g.findEdgePairByID(from, to, id)
+}
@@ -0,0 +1,198 @@
+package graph
+
+// Subgraph builds the subgraph induced by a set of nodes: the nodes themselves and every edge between two of them.
+// The result is a new graph of the same type; names, distances and attributes are copied.
+//
+// Parameters:
+//   - identifiers: The identifiers of the nodes to keep. Duplicates are ignored.
+//
+// Returns:
+//   - The new graph, whose nodes are numbered 0..k-1 in ascending order of their original identifiers.
+//   - The mapping from the identifiers of the new graph back to the original identifiers.
+//   - An error if one of the nodes does not exist.
+func (g *Graph) Subgraph(identifiers []NodeID) (*Graph, IDMap, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	for _, id := range identifiers {
+		if g.nodes.find(id) == nil {
+			return nil, nil, &NotExistNodeError{ID: id}
+		}
+	}
+
+	result, positions, mapping := g.derive(identifiers)
+	undirected := g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED
+
+	for _, original := range mapping.Inverse().sortedKeys() {
+		for _, e := range g.nodes.find(original).edges {
+			to, ok := positions[e.to]
+
+			if ok && (!undirected || original <= e.to) {
+				result.appendEdge(positions[original], to, e.distance, e.attributes, e.payload)
+			}
+		}
+	}
+
+	return result, mapping, nil
+}
+
+// EdgeSubgraph builds the subgraph made of a set of edges and their endpoints.
+// The result is a new graph of the same type; names, distances and attributes are copied.
+//
+// Parameters:
+//   - edges: The edges to keep. Undirected edges may be given with either endpoint first, and duplicates are ignored.
+//     In a multigraph, every parallel edge between the given nodes is kept.
+//
+// Returns:
+//   - The new graph, whose nodes are numbered 0..k-1 in ascending order of their original identifiers.
+//   - The mapping from the identifiers of the new graph back to the original identifiers.
+//   - An error if one of the edges does not exist.
+func (g *Graph) EdgeSubgraph(edges []EdgeKey) (*Graph, IDMap, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	endpoints := make([]NodeID, 0, 2*len(edges))
+
+	for _, key := range edges {
+		from := g.nodes.find(key.From)
+
+		if from == nil {
+			return nil, nil, &NotExistNodeError{ID: key.From}
+		}
+		if g.nodes.find(key.To) == nil {
+			return nil, nil, &NotExistNodeError{ID: key.To}
+		}
+
+		if from.findEdge(key.To) == nil {
+			return nil, nil, &NotExistEdgeError{From: key.From, To: key.To}
+		}
+
+		endpoints = append(endpoints, key.From, key.To)
+	}
+
+	result, positions, mapping := g.derive(endpoints)
+	seen := make(map[EdgeKey]bool, len(edges))
+
+	for _, key := range edges {
+		if pair := NewEdgeKey(key.From, key.To, g.isDirected()); !seen[pair] {
+			seen[pair] = true
+
+			for _, e := range g.nodes.find(key.From).findEdges(key.To) {
+				result.appendEdge(positions[key.From], positions[key.To], e.distance, e.attributes, e.payload)
+			}
+		}
+	}
+
+	return result, mapping, nil
+}
+
+// TranslateKeys re-keys a map of per-node values, such as the scores computed on a subgraph, with a mapping.
+//
+// Parameters:
+//   - mapping: The mapping to apply, for example the one returned by Subgraph.
+//   - values: The values keyed by node identifier.
+//
+// Returns:
+//   - The values keyed by the mapped identifiers. Identifiers missing from the mapping are dropped.
+func TranslateKeys[V any](mapping IDMap, values map[NodeID]V) map[NodeID]V {
+	result := make(map[NodeID]V, len(values))
+
+	for id, value := range values {
+		if mapped, ok := mapping.Translate(id); ok {
+			result[mapped] = value
+		}
+	}
+
+	return result
+}
+
+// derive creates an empty graph of the same type holding copies of the given nodes. The caller must hold the lock.
+//
+// Parameters:
+//   - identifiers: The identifiers of existing nodes to copy. Duplicates are ignored.
+//
+// Returns:
+//   - The new graph with the same options, whose nodes are numbered 0..k-1 in ascending order of their original identifiers.
+//   - The new identifier of every copied node, keyed by its original identifier.
+//   - The mapping from the new identifiers back to the original identifiers.
+func (g *Graph) derive(identifiers []NodeID) (*Graph, map[NodeID]NodeID, IDMap) {
+	unique := make(IDMap, len(identifiers))
+
+	for _, id := range identifiers {
+		unique[id] = id
+	}
+
+	originals := unique.sortedKeys()
+	result := NewGraph(g.graphType, len(originals), g.options)
+	positions := make(map[NodeID]NodeID, len(originals))
+	mapping := make(IDMap, len(originals))
+
+	for _, original := range originals {
+		node := g.nodes.find(original)
+		copied := result.appendNode(node.name, node.attributes, node.payload)
+		positions[original] = copied.identifier
+		mapping[copied.identifier] = original
+	}
+
+	return result, positions, mapping
+}
+
+// appendNode adds a node without taking the lock, for graphs that are still being built.
+//
+// Parameters:
+//   - name: The display name for the node.
+//   - attributes: Properties to copy onto the node.
+//   - payload: The user-defined data of the node, or nil.
+//
+// Returns the newly created Node.
+func (g *Graph) appendNode(name string, attributes Attributes, payload any) *Node {
+	node := newNode(g.nowID, name)
+	node.attributes = attributes.clone()
+	node.payload = payload
+	node.epoch = g.epoch
+	g.nodes.insert(node)
+	g.nowID++
+	g.modified()
+
+	return node
+}
+
+// appendEdge adds an edge without taking the lock or validating it, for graphs that are still being built.
+// For undirected graphs the reverse edge is added as well, unless the edge is a self-loop.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - distance: The weight of the edge.
+//   - attributes: Properties to copy onto the edge.
+//   - payload: The user-defined data of the edge, or nil.
+//
+// Returns false if the edge already exists and the graph is not a multigraph, in which case nothing is changed.
+func (g *Graph) appendEdge(from, to NodeID, distance Distance, attributes Attributes, payload any) bool {
+	f := g.nodes.find(from)
+	t := g.nodes.find(to)
+	parallel := g.HasOption(MULTIGRAPH)
+
+	e, err := f.addEdge(g.nowEdgeID, to, distance, parallel)
+
+	if err != nil {
+		return false
+	}
+
+	e.attributes = attributes.clone()
+	e.payload = payload
+	t.addIncoming(from)
+
+	if (g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED) && from != to {
+		reverse, _ := t.addEdge(g.nowEdgeID, from, distance, parallel)
+		reverse.attributes = attributes.clone()
+		reverse.payload = payload
+		f.addIncoming(to)
+	}
+
+	g.nowEdgeID++
+	g.modified()
+	g.edgeCount++
+
+	return true
+}
@@ -0,0 +1,297 @@
+package graph
+
+import (
+	"sort"
+)
+
+// Transpose returns a new graph with the direction of every edge reversed.
+// Node identifiers, names, distances and attributes are kept; an undirected graph is simply copied.
+//
+// Returns:
+//   - The transposed graph, of the same type.
+func (g *Graph) Transpose() *Graph {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	result := g.replicate(g.graphType, g.index().ids)
+	undirected := g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED
+
+	for _, id := range g.index().ids {
+		for _, e := range g.nodes.find(id).edges {
+			if !undirected {
+				result.appendEdge(e.to, id, e.distance, e.attributes, e.payload)
+			} else if id <= e.to {
+				result.appendEdge(id, e.to, e.distance, e.attributes, e.payload)
+			}
+		}
+	}
+
+	return result
+}
+
+// Complement returns a new graph that has an edge exactly where this graph has none.
+// Node identifiers, names and attributes are kept.
+//
+// Returns:
+//   - The complement graph, of the same type. In weighted graphs every edge has distance 1.
+//
+// Notes:
+//   - Self-loops are only added if the graph has the ALLOW_SELF_LOOPS option, on the nodes without one.
+func (g *Graph) Complement() *Graph {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	result := g.replicate(g.graphType, g.index().ids)
+	undirected := g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED
+	ids := g.index().ids
+
+	for _, from := range ids {
+		node := g.nodes.find(from)
+
+		for _, to := range ids {
+			if (from == to && !g.HasOption(ALLOW_SELF_LOOPS)) || (undirected && to < from) || node.findEdge(to) != nil {
+				continue
+			}
+
+			result.appendEdge(from, to, 1, nil, nil)
+		}
+	}
+
+	return result
+}
+
+// LineGraph returns the line graph, which has one node per edge of this graph.
+// In a directed graph, the node of edge (a, b) has an edge to the node of every edge (b, c).
+// In an undirected graph, the nodes of two edges are adjacent if the edges share an endpoint.
+//
+// Returns:
+//   - The line graph, unweighted and with the same directedness. Every node is named after the names
+//     of the endpoints of its edge, joined by "-", and carries a copy of the edge's attributes.
+//   - The edge of this graph that every node of the line graph stands for, indexed by identifier.
+//     Edges are numbered in ascending order of their endpoints; undirected edges have From < To.
+//     Parallel edges of a multigraph get a node each, in ascending order of EdgeID.
+func (g *Graph) LineGraph() (*Graph, []EdgeKey) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	undirected := g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED
+	lineType := DIRECTED_UNWEIGHTED
+
+	if undirected {
+		lineType = UNDIRECTED_UNWEIGHTED
+	}
+
+	result := NewGraph(lineType, g.edgeCount, g.options&ALLOW_SELF_LOOPS)
+	keys := make([]EdgeKey, 0, g.edgeCount)
+	incident := make(map[NodeID][]NodeID) // Line graph nodes of the edges leaving (or touching) every node.
+
+	for _, id := range g.index().ids {
+		node := g.nodes.find(id)
+		leaving := make([]*edge, 0, len(node.edges))
+
+		for _, e := range node.edges {
+			if !undirected || id <= e.to {
+				leaving = append(leaving, e)
+			}
+		}
+
+		sort.Slice(leaving, func(i, j int) bool {
+			if leaving[i].to != leaving[j].to {
+				return leaving[i].to < leaving[j].to
+			}
+
+			return leaving[i].id < leaving[j].id
+		})
+
+		for _, e := range leaving {
+			copied := result.appendNode(node.name+"-"+g.nodes.find(e.to).name, e.attributes, nil)
+			keys = append(keys, EdgeKey{From: id, To: e.to})
+
+			incident[id] = append(incident[id], copied.identifier)
+
+			if undirected {
+				incident[e.to] = append(incident[e.to], copied.identifier)
+			}
+		}
+	}
+
+	for line, key := range keys {
+		from := NodeID(line)
+
+		if undirected {
+			for _, endpoint := range []NodeID{key.From, key.To} {
+				for _, to := range incident[endpoint] {
+					if from < to {
+						result.appendEdge(from, to, 1, nil, nil)
+					}
+				}
+			}
+		} else {
+			for _, to := range incident[key.To] {
+				// Only the node of a self-loop can follow itself.
+				if from != to || result.HasOption(ALLOW_SELF_LOOPS) {
+					result.appendEdge(from, to, 1, nil, nil)
+				}
+			}
+		}
+	}
+
+	return result, keys
+}
+
+// ToUndirected returns an undirected copy of the graph.
+// Every edge becomes an undirected edge; two opposite edges of a directed graph, as well as parallel edges
+// of a multigraph, become one edge whose distance is combined with `merge` and whose attributes are the union of all,
+// with the edge from the smaller identifier taking precedence.
+//
+// Parameters:
+//   - merge: How the distances of two opposite edges are combined. Ignored for unweighted graphs.
+//
+// Returns:
+//   - The undirected graph, weighted if this graph is weighted. Node identifiers, names and attributes are kept.
+//   - An *InvalidEdgeError if a merged distance is one an undirected graph does not accept: a negative distance
+//     of a DIRECTED_WEIGHTED graph, which would be a negative cycle, or a sum that overflows.
+func (g *Graph) ToUndirected(merge WeightMerge) (*Graph, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	undirected := g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED
+	resultType := UNDIRECTED_UNWEIGHTED
+
+	if g.graphType == DIRECTED_WEIGHTED || g.graphType == UNDIRECTED_WEIGHTED {
+		resultType = UNDIRECTED_WEIGHTED
+	}
+
+	result := g.replicate(resultType, g.index().ids)
+
+	for _, id := range g.index().ids {
+		for _, e := range g.nodes.find(id).edges {
+			if !undirected || id <= e.to {
+				if err := result.mergeEdge(id, e.to, e.distance, e.attributes, e.payload, merge); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	// Opposite edges are merged before their distances are checked, so a negative edge may be offset by its reverse.
+	for _, id := range result.index().ids {
+		for _, e := range result.nodes.find(id).edges {
+			if !result.validWeight(e.distance) {
+				return nil, &InvalidEdgeError{Type: result.graphType, Distance: e.distance}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// ToDirected returns a directed copy of the graph.
+// Every undirected edge becomes two opposite directed edges with the same distance and attributes;
+// a directed graph is simply copied.
+//
+// Returns:
+//   - The directed graph, weighted if this graph is weighted. Node identifiers, names and attributes are kept.
+func (g *Graph) ToDirected() *Graph {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	resultType := DIRECTED_UNWEIGHTED
+
+	if g.graphType == DIRECTED_WEIGHTED || g.graphType == UNDIRECTED_WEIGHTED {
+		resultType = DIRECTED_WEIGHTED
+	}
+
+	result := g.replicate(resultType, g.index().ids)
+
+	for _, id := range g.index().ids {
+		for _, e := range g.nodes.find(id).edges {
+			result.appendEdge(id, e.to, e.distance, e.attributes, e.payload)
+		}
+	}
+
+	return result
+}
+
+// replicate creates a graph of the given type holding copies of some nodes with their identifiers, but no edges.
+// The caller must hold the lock.
+//
+// Parameters:
+//   - graphType: The type of the new graph.
+//   - identifiers: The identifiers of the nodes to copy.
+//
+// Returns the new graph with the same options, which allocates identifiers after the ones of this graph.
+func (g *Graph) replicate(graphType GraphType, identifiers []NodeID) *Graph {
+	result := NewGraph(graphType, len(identifiers), g.options)
+
+	for _, id := range identifiers {
+		node := g.nodes.find(id)
+		copied := newNode(id, node.name)
+		copied.attributes = node.attributes.clone()
+		copied.payload = node.payload
+		result.nodes.insert(copied)
+	}
+
+	result.nowID = g.nowID
+
+	return result
+}
+
+// mergeEdge adds an edge like appendEdge, or merges it into the edge that already exists between the same nodes.
+// In a multigraph, it is merged into the edge added first rather than added as a parallel edge.
+// The distances are combined with `merge` in weighted graphs, and the attributes of the existing edge take precedence.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - distance: The weight of the edge.
+//   - attributes: Properties to copy onto the edge.
+//   - payload: The user-defined data of the edge, kept only if the existing edge has none.
+//   - merge: How the distances are combined.
+//
+// Returns an *InvalidEdgeError, leaving the graph unchanged, if the combined distance overflows.
+func (g *Graph) mergeEdge(from, to NodeID, distance Distance, attributes Attributes, payload any, merge WeightMerge) error {
+	first := g.nodes.find(from).findEdge(to)
+
+	if first == nil {
+		g.appendEdge(from, to, distance, attributes, payload)
+		return nil
+	}
+
+	weighted := g.graphType == DIRECTED_WEIGHTED || g.graphType == UNDIRECTED_WEIGHTED
+	merged := merge.apply(first.distance, distance)
+
+	if weighted && !merged.IsFinite() {
+		return &InvalidEdgeError{Type: g.graphType, Distance: merged}
+	}
+
+	copies := []*edge{first}
+
+	if (g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED) && from != to {
+		copies = append(copies, g.nodes.find(to).findEdgeByID(from, first.id))
+	}
+
+	for _, e := range copies {
+		if weighted {
+			e.distance = merged
+		}
+
+		for key, value := range attributes {
+			if _, exists := e.attributes[key]; !exists {
+				if e.attributes == nil {
+					e.attributes = Attributes{}
+				}
+
+				e.attributes[key] = value
+			}
+		}
+
+		if e.payload == nil {
+			e.payload = payload
+		}
+	}
+
+	g.modified()
+
+	return nil
+}
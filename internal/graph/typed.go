@@ -0,0 +1,257 @@
+package graph
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// TypedGraph is a Graph whose nodes and edges carry user-defined data of the types N and E,
+// so that domain values such as servers or users live in the graph instead of a side map.
+// Every other operation is provided by the embedded Graph. Payloads follow their nodes and edges
+// into snapshots, subgraphs, transforms and set operations, so the results can be wrapped again with Typed.
+//
+// Fields:
+//   - Graph: The underlying graph, which Unit and ParallelUnit compute on.
+//   - weight: Turns an edge payload into the distance of the edge, or nil to give every edge distance 1.
+type TypedGraph[N, E any] struct {
+	*Graph                  // The underlying graph.
+	weight func(E) Distance // Turns an edge payload into the distance of the edge.
+}
+
+// NewTypedGraph creates a new graph whose nodes carry payloads of type N and whose edges carry payloads of type E.
+//
+// Parameters:
+//   - graphType: The type of the graph (directed/undirected, weighted/unweighted).
+//   - capacity: The initial capacity for nodes.
+//   - weight: Turns an edge payload into the distance of the edge in weighted graphs.
+//     If nil, or if the graph is unweighted, every edge has distance 1.
+//   - options: Optional rules for the graph to enforce, such as UNIQUE_NAMES.
+//
+// Returns a pointer to the newly created TypedGraph.
+func NewTypedGraph[N, E any](graphType GraphType, capacity int, weight func(E) Distance, options ...Option) *TypedGraph[N, E] {
+	return Typed[N](NewGraph(graphType, capacity, options...), weight)
+}
+
+// Typed wraps an existing graph, for example one derived from a TypedGraph by Subgraph or Union,
+// to access its payloads as N and E.
+//
+// Parameters:
+//   - g: The graph to wrap.
+//   - weight: See NewTypedGraph.
+//
+// Returns a pointer to a TypedGraph sharing the graph.
+func Typed[N, E any](g *Graph, weight func(E) Distance) *TypedGraph[N, E] {
+	return &TypedGraph[N, E]{Graph: g, weight: weight}
+}
+
+// AddNodeWith adds a new node to the graph like AddNode and stores a payload on it.
+//
+// Parameters:
+//   - name: The display name for the node.
+//   - payload: The user-defined data of the node.
+//
+// Returns the newly created Node and an error if insertion fails.
+func (t *TypedGraph[N, E]) AddNodeWith(name string, payload N) (*Node, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	node, err := t.addNode("AddNodeWith", name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	node.payload = payload
+
+	return node, nil
+}
+
+// NodePayload retrieves the payload of a node.
+//
+// Parameters:
+//   - identifier: The identifier of the node.
+//
+// Returns the payload, or the zero value of N if the node has none, and an error if the node does not exist
+// or its payload is not of type N.
+func (t *TypedGraph[N, E]) NodePayload(identifier NodeID) (N, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var payload N
+	node := t.nodes.find(identifier)
+
+	if node == nil {
+		return payload, &NotExistNodeError{ID: identifier}
+	}
+
+	return typedPayload[N](node.payload, identifier)
+}
+
+// SetNodePayload replaces the payload of a node.
+//
+// Parameters:
+//   - identifier: The identifier of the node.
+//   - payload: The user-defined data of the node.
+//
+// Returns an error if the node does not exist.
+func (t *TypedGraph[N, E]) SetNodePayload(identifier NodeID, payload N) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.writable("SetNodePayload"); err != nil {
+		return err
+	}
+
+	node := t.own(identifier)
+
+	if node == nil {
+		return &NotExistNodeError{ID: identifier}
+	}
+
+	node.payload = payload
+
+	return nil
+}
+
+// AddEdgeWith adds an edge carrying a payload, whose distance is taken from the payload by the weight function.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - payload: The user-defined data of the edge.
+//
+// Returns the identifier of the new edge, and an error if the edge cannot be added as with InsertEdge,
+// including when the weight function yields a distance the graph does not accept.
+func (t *TypedGraph[N, E]) AddEdgeWith(from, to NodeID, payload E) (EdgeID, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	id, err := t.insertEdge("AddEdgeWith", from, to, t.distance(payload))
+
+	if err != nil {
+		return 0, err
+	}
+
+	// insertEdge has already owned both nodes.
+	for _, e := range t.edgeCopies(from, to, id) {
+		e.payload = payload
+	}
+
+	return id, nil
+}
+
+// EdgePayload retrieves the payload of an edge. In a multigraph, the edge added first is used.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//
+// Returns the payload, or the zero value of E if the edge has none, and an error if the edge does not exist
+// or its payload is not of type E.
+func (t *TypedGraph[N, E]) EdgePayload(from, to NodeID) (E, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var payload E
+	edges, err := t.findEdgePair(from, to)
+
+	if err != nil {
+		return payload, err
+	}
+
+	return typedPayload[E](edges[0].payload, EdgeKey{From: from, To: to})
+}
+
+// SetEdgePayload replaces the payload of an edge and updates its distance with the weight function.
+// In a multigraph, the edge added first is changed.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - payload: The user-defined data of the edge.
+//
+// Returns an error if the edge does not exist or the new distance is not accepted by the graph.
+//
+// Notes:
+//   - The graph's `updated` flag is set to false, since the distance of the edge may change.
+func (t *TypedGraph[N, E]) SetEdgePayload(from, to NodeID, payload E) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.writable("SetEdgePayload"); err != nil {
+		return err
+	}
+
+	distance := t.distance(payload)
+
+	if !t.validWeight(distance) {
+		return &InvalidEdgeError{Type: t.graphType, Distance: distance}
+	}
+
+	edges, err := t.ownEdgePair(from, to)
+
+	if err != nil {
+		return err
+	}
+
+	for _, e := range edges {
+		e.payload = payload
+		e.distance = distance
+	}
+
+	t.modified() // Mark the graph as modified.
+
+	return nil
+}
+
+// Snapshot returns a read-only view of the graph as it is now, with the same payload types and weight function.
+//
+// Returns a pointer to the snapshot.
+func (t *TypedGraph[N, E]) Snapshot() *TypedGraph[N, E] {
+	return Typed[N](t.Graph.Snapshot(), t.weight)
+}
+
+// typedPayload converts a stored payload to the type it is read as.
+//
+// Parameters:
+//   - payload: The stored payload, nil if there is none.
+//   - owner: The node or edge holding the payload, for the error.
+//
+// Returns the payload, or the zero value of T if there is none, and a *PayloadTypeError if it holds another type.
+func typedPayload[T any](payload any, owner fmt.Stringer) (T, error) {
+	var value T
+
+	if payload == nil {
+		return value, nil
+	}
+
+	value, ok := payload.(T)
+
+	if !ok {
+		return value, &PayloadTypeError{Owner: owner, Expected: reflect.TypeOf(&value).Elem().String(), Actual: fmt.Sprintf("%T", payload)}
+	}
+
+	return value, nil
+}
+
+// distance returns the distance of an edge carrying the given payload.
+// It is 1 for unweighted graphs and when there is no weight function.
+func (t *TypedGraph[N, E]) distance(payload E) Distance {
+	if t.weight == nil || t.graphType == DIRECTED_UNWEIGHTED || t.graphType == UNDIRECTED_UNWEIGHTED {
+		return 1
+	}
+
+	return t.weight(payload)
+}
+
+// edgeCopies returns the stored copies of one edge: the edge itself and, in undirected graphs, its reverse.
+// The caller must hold the lock.
+func (g *Graph) edgeCopies(from, to NodeID, id EdgeID) []*edge {
+	edges := []*edge{g.nodes.find(from).findEdgeByID(to, id)}
+
+	if (g.graphType == UNDIRECTED_UNWEIGHTED || g.graphType == UNDIRECTED_WEIGHTED) && from != to {
+		edges = append(edges, g.nodes.find(to).findEdgeByID(from, id))
+	}
+
+	return edges
+}
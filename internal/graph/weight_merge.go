@@ -0,0 +1,45 @@
+package graph
+
+// WeightMerge is an enumeration that defines how the weights of two edges are combined
+// when they become a single edge, for example when a directed graph is made undirected.
+type WeightMerge int
+
+// Enumeration values for WeightMerge.
+const (
+	MERGE_MIN WeightMerge = iota // Keep the smaller weight.
+	MERGE_MAX                    // Keep the larger weight.
+	MERGE_SUM                    // Add the weights together.
+)
+
+// String converts a WeightMerge value to its string representation.
+func (m WeightMerge) String() string {
+	switch m {
+	case MERGE_MIN:
+		return "Minimum Merge"
+	case MERGE_MAX:
+		return "Maximum Merge"
+	case MERGE_SUM:
+		return "Sum Merge"
+	default:
+		return "Unknown Merge"
+	}
+}
+
+// apply combines two weights according to the merge rule.
+// Unknown rules behave like MERGE_MIN.
+func (m WeightMerge) apply(a, b Distance) Distance {
+	switch m {
+	case MERGE_MAX:
+		if b > a {
+			return b
+		}
+		return a
+	case MERGE_SUM:
+		return a + b
+	default:
+		if b < a {
+			return b
+		}
+		return a
+	}
+}
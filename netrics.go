@@ -6,15 +6,41 @@ import (
 )
 
 // Type aliases for commonly used graph-related types from the internal packages.
-type GraphType = graph.GraphType // Represents the type of graph (directed/undirected, weighted/unweighted).
-type Distance = graph.Distance   // Represents the weight or distance between nodes.
-type Node = graph.Node           // Represents a node in the graph.
-type NodeID = graph.NodeID       // Represents the unique identifier of a node.
-type Matrix = graph.Matrix       // Represents the adjacency matrix of the graph.
+type GraphType = graph.GraphType     // Represents the type of graph (directed/undirected, weighted/unweighted).
+type Distance = graph.Distance       // Represents the weight or distance between nodes.
+type Node = graph.Node               // Represents a node in the graph.
+type EdgeID = graph.EdgeID           // Represents the unique identifier of an edge.
+type NodeID = graph.NodeID           // Represents the unique identifier of a node.
+type Matrix = graph.Matrix           // Represents the adjacency matrix of the graph.
+type EdgeKey = graph.EdgeKey         // Represents an edge identified by its endpoints.
+type Edge = graph.Edge               // Represents an edge with its endpoints and distance.
+type Attributes = graph.Attributes   // Represents user-defined properties of a node or an edge.
+type Index = graph.Index             // Represents a dense mapping between node identifiers and contiguous positions.
+type IDMap = graph.IDMap             // Represents a mapping between two numberings of node identifiers.
+type NodePair = graph.NodePair       // Represents a node of a product graph by the nodes it was made from.
+type Option = graph.Option           // Represents rules a graph enforces, fixed at creation.
+type WeightMerge = graph.WeightMerge // Represents how the weights of two merged edges are combined.
+
+// Type aliases for the structured errors, for use with errors.As.
+type InvalidEdgeError = graph.InvalidEdgeError               // Carries the graph type and the rejected weight.
+type SelfEdgeError = graph.SelfEdgeError                     // Carries the identifier of the node.
+type AlreadyEdgeError = graph.AlreadyEdgeError               // Carries the endpoints of the edge.
+type NotExistEdgeError = graph.NotExistEdgeError             // Carries the endpoints of the edge.
+type AlreadyNodeError = graph.AlreadyNodeError               // Carries the identifier of the node.
+type NotExistNodeError = graph.NotExistNodeError             // Carries the identifier or name of the node.
+type NotExistAttributeError = graph.NotExistAttributeError   // Carries the owner and the name of the attribute.
+type TypeMismatchError = graph.TypeMismatchError             // Carries the types of both graphs.
+type ReadOnlyError = graph.ReadOnlyError                     // Carries the name of the rejected operation.
+type PayloadTypeError = graph.PayloadTypeError               // Carries the owner and both types.
+type NotConvergedError = algorithm.NotConvergedError         // Carries the algorithm and the number of iterations.
+type InvalidParameterError = algorithm.InvalidParameterError // Carries the parameter and the reason.
+type NegativeCycleError = algorithm.NegativeCycleError       // Carries the cycle as a Path.
 
 // Type aliases for algorithm-related structures from the internal packages.
-type Unit = algorithm.Unit                 // Represents a computation unit for sequential graph algorithms.
-type ParallelUnit = algorithm.ParallelUnit // Represents a computation unit for parallel graph algorithms.
+type Unit = algorithm.Unit                   // Represents a computation unit for sequential graph algorithms.
+type ParallelUnit = algorithm.ParallelUnit   // Represents a computation unit for parallel graph algorithms.
+type Collapse = algorithm.Collapse           // Represents how parallel edges are combined by algorithms.
+type Normalization = algorithm.Normalization // Represents how path-based centrality scores are scaled.
 
 // GraphParams wraps the internal graph.Graph to implement the Graph interface.
 type GraphParams struct{ *graph.Graph }
@@ -22,25 +48,87 @@ type GraphParams struct{ *graph.Graph }
 // PathParams wraps the internal graph.Path to implement the Path interface.
 type PathParams struct{ *graph.Path }
 
+// GraphBuilder wraps the internal graph.Builder so that Build returns a Graph.
+type GraphBuilder struct{ *graph.Builder }
+
+// TypedGraphParams wraps the internal graph.TypedGraph to implement the TypedGraph interface.
+type TypedGraphParams[N, E any] struct {
+	*GraphParams                         // Provides the methods of the Graph interface.
+	typed        *graph.TypedGraph[N, E] // Provides the payload methods.
+}
+
 // Graph defines the interface for interacting with graph structures.
 // It includes methods for managing nodes and edges, retrieving graph properties, and converting to computation units.
 type Graph interface {
-	AddNode(name string) (*Node, error)                     // Adds a new node to the graph.
-	RemoveNode(identifier NodeID) error                     // Removes a node from the graph.
-	FindNode(identifier NodeID) (*Node, error)              // Finds a node by its identifier.
-	FindNodesByName(name string) ([]*Node, error)           // Finds all nodes with the given name.
-	AddEdge(from, to NodeID) error                          // Adds an unweighted edge between two nodes.
-	AddWeightEdge(from, to NodeID, distance Distance) error // Adds a weighted edge between two nodes.
-	RemoveEdge(from, to NodeID) error                       // Removes an edge between two nodes.
-	FindEdge(from, to NodeID) (*Distance, error)            // Finds the distance of an edge between two nodes.
-	Matrix() Matrix                                         // Returns the adjacency matrix of the graph.
-	String() string                                         // Returns a string representation of the graph.
-	NodeCount() int                                         // Returns the number of nodes in the graph.
-	EdgeCount() int                                         // Returns the number of edges in the graph.
-	Type() GraphType                                        // Returns the type of the graph.
-	IsUpdated() bool                                        // Checks if the graph has been updated since the last computation.
-	ToUnit() *Unit                                          // Converts the graph to a Unit for sequential computation.
-	ToParallelUnit(core uint) *ParallelUnit                 // Converts the graph to a ParallelUnit for parallel computation.
+	AddNode(name string) (*Node, error)                                           // Adds a new node to the graph.
+	RemoveNode(identifier NodeID) error                                           // Removes a node from the graph.
+	FindNode(identifier NodeID) (*Node, error)                                    // Finds a node by its identifier.
+	FindNodesByName(name string) ([]*Node, error)                                 // Finds all nodes with the given name.
+	RenameNode(identifier NodeID, name string) error                              // Changes the name of a node.
+	AddEdge(from, to NodeID) error                                                // Adds an unweighted edge between two nodes.
+	AddWeightEdge(from, to NodeID, distance Distance) error                       // Adds a weighted edge between two nodes.
+	InsertEdge(from, to NodeID, distance Distance) (EdgeID, error)                // Adds a weighted edge and returns its identifier.
+	RemoveEdge(from, to NodeID) error                                             // Removes an edge between two nodes.
+	RemoveEdgeByID(from, to NodeID, id EdgeID) error                              // Removes one of the parallel edges between two nodes.
+	FindEdge(from, to NodeID) (*Distance, error)                                  // Finds the distance of the first edge between two nodes; see FindEdges.
+	FindEdges(from, to NodeID) ([]Edge, error)                                    // Finds all parallel edges between two nodes.
+	Neighbors(identifier NodeID) ([]NodeID, error)                                // Returns the nodes a node has an edge to.
+	InNeighbors(identifier NodeID) ([]NodeID, error)                              // Returns the nodes that have an edge to a node.
+	OutDegree(identifier NodeID) (int, error)                                     // Returns the number of edges leaving a node.
+	InDegree(identifier NodeID) (int, error)                                      // Returns the number of edges entering a node.
+	Edges() func(yield func(Edge) bool)                                           // Returns an iterator over all edges.
+	Nodes() func(yield func(*Node) bool)                                          // Returns an iterator over all nodes.
+	SetNodeAttribute(identifier NodeID, key string, value any) error              // Stores a property on a node.
+	NodeAttribute(identifier NodeID, key string) (any, error)                     // Retrieves a property of a node.
+	NodeAttributes(identifier NodeID) (Attributes, error)                         // Retrieves a copy of all properties of a node.
+	RemoveNodeAttribute(identifier NodeID, key string) error                      // Deletes a property from a node.
+	SetEdgeAttribute(from, to NodeID, key string, value any) error                // Stores a property on an edge.
+	EdgeAttribute(from, to NodeID, key string) (any, error)                       // Retrieves a property of an edge.
+	EdgeAttributes(from, to NodeID) (Attributes, error)                           // Retrieves a copy of all properties of an edge.
+	RemoveEdgeAttribute(from, to NodeID, key string) error                        // Deletes a property from an edge.
+	SetEdgeAttributeByID(from, to NodeID, id EdgeID, key string, value any) error // Stores a property on one of the parallel edges.
+	EdgeAttributeByID(from, to NodeID, id EdgeID, key string) (any, error)        // Retrieves a property of one of the parallel edges.
+	EdgeAttributesByID(from, to NodeID, id EdgeID) (Attributes, error)            // Retrieves a copy of all properties of one of the parallel edges.
+	RemoveEdgeAttributeByID(from, to NodeID, id EdgeID, key string) error         // Deletes a property from one of the parallel edges.
+	Matrix() Matrix                                                               // Returns the adjacency matrix of the graph.
+	WeightMatrix(key string) Matrix                                               // Returns the adjacency matrix weighted by an edge property.
+	Index() *Index                                                                // Returns a dense index of the nodes in the graph.
+	Compact() (IDMap, error)                                                      // Renumbers the nodes without gaps and returns the old-to-new mapping.
+	Snapshot() Graph                                                              // Returns a read-only view of the graph as it is now.
+	Subgraph(identifiers []NodeID) (Graph, IDMap, error)                          // Returns the subgraph induced by some nodes and the new-to-original mapping.
+	EdgeSubgraph(edges []EdgeKey) (Graph, IDMap, error)                           // Returns the subgraph made of some edges and the new-to-original mapping.
+	Transpose() Graph                                                             // Returns a copy of the graph with every edge reversed.
+	Complement() Graph                                                            // Returns the graph with an edge exactly where this one has none.
+	LineGraph() (Graph, []EdgeKey)                                                // Returns the line graph and the edge every one of its nodes stands for.
+	ToUndirected(merge WeightMerge) (Graph, error)                                // Returns an undirected copy, merging opposite edges.
+	ToDirected() Graph                                                            // Returns a directed copy, splitting undirected edges in both directions.
+	Union(other Graph, mapping IDMap, merge WeightMerge) (Graph, IDMap, error)    // Combines two graphs, merging matching nodes and edges.
+	DisjointUnion(other Graph) (Graph, IDMap, error)                              // Places two graphs side by side.
+	Intersection(other Graph, mapping IDMap, merge WeightMerge) (Graph, error)    // Keeps the matching nodes and the edges present in both graphs.
+	Difference(other Graph, mapping IDMap) (Graph, error)                         // Keeps the edges that the other graph does not have.
+	CartesianProduct(other Graph) (Graph, []NodePair, error)                      // Returns the Cartesian product of two graphs.
+	HasOption(option Option) bool                                                 // Checks if the graph was created with an option.
+	IsReadOnly() bool                                                             // Checks if the graph is a read-only snapshot.
+	String() string                                                               // Returns a string representation of the graph.
+	NodeCount() int                                                               // Returns the number of nodes in the graph.
+	EdgeCount() int                                                               // Returns the number of edges in the graph.
+	Type() GraphType                                                              // Returns the type of the graph.
+	IsUpdated() bool                                                              // Checks if the graph has been updated since the last computation.
+	Version() uint64                                                              // Returns a counter incremented on every modification of the graph.
+	ToUnit() *Unit                                                                // Converts the graph to a Unit for sequential computation.
+	ToParallelUnit(core uint) *ParallelUnit                                       // Converts the graph to a ParallelUnit for parallel computation.
+}
+
+// TypedGraph extends Graph with user-defined data of type N on every node and of type E on every edge.
+// Unit and ParallelUnit work on it like on any Graph, using the distances the weight function took from the payloads.
+type TypedGraph[N, E any] interface {
+	Graph
+	AddNodeWith(name string, payload N) (*Node, error)      // Adds a new node carrying a payload.
+	NodePayload(identifier NodeID) (N, error)               // Retrieves the payload of a node.
+	SetNodePayload(identifier NodeID, payload N) error      // Replaces the payload of a node.
+	AddEdgeWith(from, to NodeID, payload E) (EdgeID, error) // Adds an edge carrying a payload, weighted by it.
+	EdgePayload(from, to NodeID) (E, error)                 // Retrieves the payload of an edge.
+	SetEdgePayload(from, to NodeID, payload E) error        // Replaces the payload and the distance of an edge.
 }
 
 // Path defines the interface for interacting with paths in a graph.
@@ -56,16 +144,444 @@ var _ Graph = (*GraphParams)(nil)
 // Ensure PathParams implements the Path interface.
 var _ Path = (*PathParams)(nil)
 
+// Ensure TypedGraphParams implements the TypedGraph interface.
+var _ TypedGraph[any, any] = (*TypedGraphParams[any, any])(nil)
+
 // NewGraph creates a new graph instance with the specified type and capacity.
 //
 // Parameters:
 //   - graphType: The type of the graph (directed/undirected, weighted/unweighted).
 //   - capacity: The initial capacity for nodes and edges.
+//   - options: Optional rules for the graph to enforce, such as UNIQUE_NAMES.
 //
 // Returns:
 //   - A Graph interface representing the new graph.
-func NewGraph(graphType GraphType, capacity int) Graph {
-	return &GraphParams{graph.NewGraph(graphType, capacity)}
+func NewGraph(graphType GraphType, capacity int, options ...Option) Graph {
+	return &GraphParams{graph.NewGraph(graphType, capacity, options...)}
+}
+
+// NewBuilder creates a builder that constructs a graph from batches of nodes and edges in one step,
+// which is much faster than repeated AddNode and AddWeightEdge calls for large graphs.
+//
+// Parameters:
+//   - graphType: The type of the graph (directed/undirected, weighted/unweighted).
+//   - options: Optional rules for the graph to enforce, such as UNIQUE_NAMES.
+//
+// Returns:
+//   - A pointer to the GraphBuilder.
+func NewBuilder(graphType GraphType, options ...Option) *GraphBuilder {
+	return &GraphBuilder{graph.NewBuilder(graphType, options...)}
+}
+
+// Build creates the graph from the nodes and edges added so far.
+//
+// Returns:
+//   - A Graph interface representing the finished graph, or nil if it could not be built; see SetStrict.
+//   - An error joining every problem found. Without strict mode, it may come with a graph
+//     that lacks the edges it reports.
+func (b *GraphBuilder) Build() (Graph, error) {
+	result, err := b.Builder.Build()
+
+	if result == nil {
+		return nil, err
+	}
+
+	return &GraphParams{result}, err
+}
+
+// NewTypedGraph creates a new graph whose nodes carry payloads of type N and whose edges carry payloads of type E.
+//
+// Parameters:
+//   - graphType: The type of the graph (directed/undirected, weighted/unweighted).
+//   - capacity: The initial capacity for nodes and edges.
+//   - weight: Turns an edge payload into the distance of the edge in weighted graphs.
+//     If nil, or if the graph is unweighted, every edge has distance 1.
+//   - options: Optional rules for the graph to enforce, such as UNIQUE_NAMES.
+//
+// Returns:
+//   - A TypedGraph interface representing the new graph.
+func NewTypedGraph[N, E any](graphType GraphType, capacity int, weight func(E) Distance, options ...Option) TypedGraph[N, E] {
+	return wrapTyped(graph.NewTypedGraph[N](graphType, capacity, weight, options...))
+}
+
+// Typed gives typed access to the payloads of a graph, for example a snapshot or a subgraph of a TypedGraph,
+// which keep the payloads of the nodes and edges they were made from.
+//
+// Parameters:
+//   - g: The graph to wrap. It must have been created by this package.
+//   - weight: See NewTypedGraph.
+//
+// Returns:
+//   - A TypedGraph interface sharing the graph.
+//   - An *InvalidParameterError if the graph is nil or was not created by this package.
+func Typed[N, E any](g Graph, weight func(E) Distance) (TypedGraph[N, E], error) {
+	inner, err := unwrap(g, "g")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapTyped(graph.Typed[N](inner, weight)), nil
+}
+
+// wrapTyped wraps an internal typed graph in the public TypedGraph interface.
+func wrapTyped[N, E any](typed *graph.TypedGraph[N, E]) TypedGraph[N, E] {
+	return &TypedGraphParams[N, E]{GraphParams: &GraphParams{typed.Graph}, typed: typed}
+}
+
+// AddNodeWith adds a new node carrying a payload.
+//
+// Parameters:
+//   - name: The display name for the node.
+//   - payload: The user-defined data of the node.
+//
+// Returns:
+//   - The newly created Node and an error if insertion fails.
+func (g *TypedGraphParams[N, E]) AddNodeWith(name string, payload N) (*Node, error) {
+	return g.typed.AddNodeWith(name, payload)
+}
+
+// NodePayload retrieves the payload of a node.
+//
+// Parameters:
+//   - identifier: The identifier of the node.
+//
+// Returns:
+//   - The payload, or the zero value of N if the node has none, and an error if the node does not exist
+//     or its payload is not of type N.
+func (g *TypedGraphParams[N, E]) NodePayload(identifier NodeID) (N, error) {
+	return g.typed.NodePayload(identifier)
+}
+
+// SetNodePayload replaces the payload of a node.
+//
+// Parameters:
+//   - identifier: The identifier of the node.
+//   - payload: The user-defined data of the node.
+//
+// Returns:
+//   - An error if the node does not exist.
+func (g *TypedGraphParams[N, E]) SetNodePayload(identifier NodeID, payload N) error {
+	return g.typed.SetNodePayload(identifier, payload)
+}
+
+// AddEdgeWith adds an edge carrying a payload, whose distance is taken from the payload by the weight function.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - payload: The user-defined data of the edge.
+//
+// Returns:
+//   - The identifier of the new edge, and an error if the edge cannot be added.
+func (g *TypedGraphParams[N, E]) AddEdgeWith(from, to NodeID, payload E) (EdgeID, error) {
+	return g.typed.AddEdgeWith(from, to, payload)
+}
+
+// EdgePayload retrieves the payload of an edge. In a multigraph, the edge added first is used.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//
+// Returns:
+//   - The payload, or the zero value of E if the edge has none, and an error if the edge does not exist
+//     or its payload is not of type E.
+func (g *TypedGraphParams[N, E]) EdgePayload(from, to NodeID) (E, error) {
+	return g.typed.EdgePayload(from, to)
+}
+
+// SetEdgePayload replaces the payload of an edge and updates its distance with the weight function.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - payload: The user-defined data of the edge.
+//
+// Returns:
+//   - An error if the edge does not exist or the new distance is not accepted by the graph.
+func (g *TypedGraphParams[N, E]) SetEdgePayload(from, to NodeID, payload E) error {
+	return g.typed.SetEdgePayload(from, to, payload)
+}
+
+// Snapshot returns a read-only view of the graph as it is now.
+// The snapshot can be converted to a Unit or ParallelUnit while the original graph keeps changing,
+// and its mutators return errors.
+//
+// Returns:
+//   - A Graph interface representing the snapshot.
+func (g *GraphParams) Snapshot() Graph {
+	return &GraphParams{g.Graph.Snapshot()}
+}
+
+// Subgraph returns the subgraph induced by a set of nodes, with every edge between two of them.
+//
+// Parameters:
+//   - identifiers: The identifiers of the nodes to keep.
+//
+// Returns:
+//   - A Graph interface representing the subgraph.
+//   - The mapping from the identifiers of the subgraph back to the original identifiers.
+//   - An error if one of the nodes does not exist.
+func (g *GraphParams) Subgraph(identifiers []NodeID) (Graph, IDMap, error) {
+	sub, mapping, err := g.Graph.Subgraph(identifiers)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &GraphParams{sub}, mapping, nil
+}
+
+// EdgeSubgraph returns the subgraph made of a set of edges and their endpoints.
+//
+// Parameters:
+//   - edges: The edges to keep.
+//
+// Returns:
+//   - A Graph interface representing the subgraph.
+//   - The mapping from the identifiers of the subgraph back to the original identifiers.
+//   - An error if one of the edges does not exist.
+func (g *GraphParams) EdgeSubgraph(edges []EdgeKey) (Graph, IDMap, error) {
+	sub, mapping, err := g.Graph.EdgeSubgraph(edges)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &GraphParams{sub}, mapping, nil
+}
+
+// Transpose returns a copy of the graph with the direction of every edge reversed.
+//
+// Returns:
+//   - A Graph interface representing the transposed graph.
+func (g *GraphParams) Transpose() Graph {
+	return &GraphParams{g.Graph.Transpose()}
+}
+
+// Complement returns a graph that has an edge exactly where this graph has none.
+//
+// Returns:
+//   - A Graph interface representing the complement graph.
+func (g *GraphParams) Complement() Graph {
+	return &GraphParams{g.Graph.Complement()}
+}
+
+// LineGraph returns the line graph, which has one node per edge of this graph.
+//
+// Returns:
+//   - A Graph interface representing the line graph.
+//   - The edge of this graph that every node of the line graph stands for, indexed by identifier.
+func (g *GraphParams) LineGraph() (Graph, []EdgeKey) {
+	line, keys := g.Graph.LineGraph()
+	return &GraphParams{line}, keys
+}
+
+// ToUndirected returns an undirected copy of the graph.
+//
+// Parameters:
+//   - merge: How the distances of two opposite edges are combined.
+//
+// Returns:
+//   - A Graph interface representing the undirected graph.
+//   - An error if a merged distance is negative or overflows, which an undirected graph does not accept.
+func (g *GraphParams) ToUndirected(merge WeightMerge) (Graph, error) {
+	result, err := g.Graph.ToUndirected(merge)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &GraphParams{result}, nil
+}
+
+// ToDirected returns a directed copy of the graph.
+//
+// Returns:
+//   - A Graph interface representing the directed graph.
+func (g *GraphParams) ToDirected() Graph {
+	return &GraphParams{g.Graph.ToDirected()}
+}
+
+// Union returns a graph with the nodes and edges of both graphs, where matching nodes are merged.
+//
+// Parameters:
+//   - other: The graph to combine with. It must have the same type.
+//   - mapping: The node of this graph that every node of the other graph corresponds to, or nil to match by name.
+//   - merge: How the distances of edges present in both graphs are combined.
+//
+// Returns:
+//   - A Graph interface representing the union graph.
+//   - The identifier in the union graph of every node of the other graph.
+//   - An error if the other graph is nil or was not created by this package, if the types differ,
+//     or if the mapping refers to nodes that do not exist.
+func (g *GraphParams) Union(other Graph, mapping IDMap, merge WeightMerge) (Graph, IDMap, error) {
+	inner, err := unwrap(other, "other")
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, translation, err := g.Graph.Union(inner, mapping, merge)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &GraphParams{result}, translation, nil
+}
+
+// DisjointUnion returns a graph with the nodes and edges of both graphs, side by side.
+//
+// Parameters:
+//   - other: The graph to combine with. It must have the same type.
+//
+// Returns:
+//   - A Graph interface representing the disjoint union graph.
+//   - The identifier in the disjoint union graph of every node of the other graph.
+//   - An error if the other graph is nil or was not created by this package, or if the types differ.
+func (g *GraphParams) DisjointUnion(other Graph) (Graph, IDMap, error) {
+	inner, err := unwrap(other, "other")
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, translation, err := g.Graph.DisjointUnion(inner)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &GraphParams{result}, translation, nil
+}
+
+// Intersection returns a graph with the matching nodes of both graphs and the edges present in both.
+//
+// Parameters:
+//   - other: The graph to combine with. It must have the same type.
+//   - mapping: The node of this graph that every node of the other graph corresponds to, or nil to match by name.
+//   - merge: How the distances of the common edges are combined.
+//
+// Returns:
+//   - A Graph interface representing the intersection graph.
+//   - An error if the other graph is nil or was not created by this package, if the types differ,
+//     or if the mapping refers to nodes that do not exist.
+func (g *GraphParams) Intersection(other Graph, mapping IDMap, merge WeightMerge) (Graph, error) {
+	inner, err := unwrap(other, "other")
+
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := g.Graph.Intersection(inner, mapping, merge)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &GraphParams{result}, nil
+}
+
+// Difference returns a graph with all nodes of this graph and the edges that the other graph does not have.
+//
+// Parameters:
+//   - other: The graph to subtract. It must have the same type.
+//   - mapping: The node of this graph that every node of the other graph corresponds to, or nil to match by name.
+//
+// Returns:
+//   - A Graph interface representing the difference graph.
+//   - An error if the other graph is nil or was not created by this package, if the types differ,
+//     or if the mapping refers to nodes that do not exist.
+func (g *GraphParams) Difference(other Graph, mapping IDMap) (Graph, error) {
+	inner, err := unwrap(other, "other")
+
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := g.Graph.Difference(inner, mapping)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &GraphParams{result}, nil
+}
+
+// CartesianProduct returns the Cartesian product of two graphs.
+//
+// Parameters:
+//   - other: The second factor. It must have the same type.
+//
+// Returns:
+//   - A Graph interface representing the product graph.
+//   - The pair of nodes that every node of the product stands for, indexed by identifier.
+//   - An error if the other graph is nil or was not created by this package, or if the types differ.
+func (g *GraphParams) CartesianProduct(other Graph) (Graph, []NodePair, error) {
+	inner, err := unwrap(other, "other")
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, pairs, err := g.Graph.CartesianProduct(inner)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &GraphParams{result}, pairs, nil
+}
+
+// unwrap returns the internal graph behind a Graph created by this package.
+//
+// Parameters:
+//   - g: The graph to unwrap.
+//   - parameter: The name of the parameter `g` was passed as, for the error.
+//
+// Returns:
+//   - The internal graph, or an *InvalidParameterError if `g` is nil or was implemented outside this package.
+func unwrap(g Graph, parameter string) (*graph.Graph, error) {
+	wrapper, ok := g.(interface{ internal() *graph.Graph })
+
+	if !ok || wrapper.internal() == nil {
+		return nil, &InvalidParameterError{Parameter: parameter, Reason: "must be a graph created by this package"}
+	}
+
+	return wrapper.internal(), nil
+}
+
+// internal returns the wrapped graph, or nil for a nil *GraphParams.
+func (g *GraphParams) internal() *graph.Graph {
+	if g == nil {
+		return nil
+	}
+
+	return g.Graph
+}
+
+// internal returns the wrapped graph, or nil for a nil *TypedGraphParams, so unwrap accepts both wrappers.
+func (g *TypedGraphParams[N, E]) internal() *graph.Graph {
+	if g == nil {
+		return nil
+	}
+
+	return g.GraphParams.internal()
+}
+
+// TranslateKeys re-keys a map of per-node values with a mapping, for example to report
+// metrics computed on a subgraph under the identifiers of the original graph.
+//
+// Parameters:
+//   - mapping: The mapping to apply.
+//   - values: The values keyed by node identifier.
+//
+// Returns:
+//   - The values keyed by the mapped identifiers. Identifiers missing from the mapping are dropped.
+func TranslateKeys[V any](mapping IDMap, values map[NodeID]V) map[NodeID]V {
+	return graph.TranslateKeys(mapping, values)
 }
 
 // ToUnit converts the GraphParams to a sequential computation unit (Unit).
@@ -87,8 +603,25 @@ func (g *GraphParams) ToParallelUnit(core uint) *ParallelUnit {
 	return algorithm.NewParallelUnit(g.Graph, core)
 }
 
-// Constants representing infinity for distances.
-const INF = Distance(graph.INF)
+// INF represents an infinite distance, used for unreachable nodes and missing edges.
+const INF = graph.INF
+
+// Sentinel errors for use with errors.Is. Every error returned by a Graph or an algorithm wraps one of them.
+var (
+	ErrInvalidEdge       = graph.ErrInvalidEdge          // An edge does not fit the graph type.
+	ErrSelfEdge          = graph.ErrSelfEdge             // An edge would connect a node to itself.
+	ErrAlreadyEdge       = graph.ErrAlreadyEdge          // An edge already exists.
+	ErrNotExistEdge      = graph.ErrNotExistEdge         // An edge does not exist.
+	ErrAlreadyNode       = graph.ErrAlreadyNode          // A node already exists.
+	ErrNotExistNode      = graph.ErrNotExistNode         // A node does not exist.
+	ErrNotExistAttribute = graph.ErrNotExistAttribute    // An attribute does not exist.
+	ErrReadOnly          = graph.ErrReadOnly             // The graph is a read-only snapshot.
+	ErrTypeMismatch      = graph.ErrTypeMismatch         // Two graphs combined by an operation have different types.
+	ErrPayloadType       = graph.ErrPayloadType          // A payload does not hold the requested type.
+	ErrNotConverged      = algorithm.ErrNotConverged     // An iterative algorithm did not converge.
+	ErrInvalidParameter  = algorithm.ErrInvalidParameter // A parameter is outside its valid range.
+	ErrNegativeCycle     = algorithm.ErrNegativeCycle    // A cycle of negative total weight makes shortest paths undefined.
+)
 
 // Constants representing graph types.
 const (
@@ -97,3 +630,31 @@ const (
 	UNDIRECTED_UNWEIGHTED = GraphType(graph.UNDIRECTED_UNWEIGHTED) // Undirected unweighted graph.
 	UNDIRECTED_WEIGHTED   = GraphType(graph.UNDIRECTED_WEIGHTED)   // Undirected weighted graph.
 )
+
+// Constants representing normalization modes for path-based centrality measures.
+const (
+	NORMALIZE_NONE  = Normalization(algorithm.NORMALIZE_NONE)  // Raw scores.
+	NORMALIZE_PAIRS = Normalization(algorithm.NORMALIZE_PAIRS) // Scores divided by the number of contributing node pairs.
+	NORMALIZE_MAX   = Normalization(algorithm.NORMALIZE_MAX)   // Scores divided by the largest score.
+)
+
+// Constants representing how the weights of two merged edges are combined.
+const (
+	MERGE_MIN = WeightMerge(graph.MERGE_MIN) // Keep the smaller weight.
+	MERGE_MAX = WeightMerge(graph.MERGE_MAX) // Keep the larger weight.
+	MERGE_SUM = WeightMerge(graph.MERGE_SUM) // Add the weights together.
+)
+
+// Constants representing graph options.
+const (
+	UNIQUE_NAMES     = Option(graph.UNIQUE_NAMES)     // Reject nodes whose name is already used by another node.
+	MULTIGRAPH       = Option(graph.MULTIGRAPH)       // Allow several parallel edges between the same pair of nodes.
+	ALLOW_SELF_LOOPS = Option(graph.ALLOW_SELF_LOOPS) // Allow edges from a node to itself.
+)
+
+// Constants representing how algorithms combine the parallel edges of a multigraph.
+const (
+	COLLAPSE_MIN   = Collapse(algorithm.COLLAPSE_MIN)   // Parallel edges count as one edge with the smallest weight.
+	COLLAPSE_COUNT = Collapse(algorithm.COLLAPSE_COUNT) // Parallel edges count separately.
+	COLLAPSE_SUM   = Collapse(algorithm.COLLAPSE_SUM)   // The combined edge weighs the sum of the weights.
+)
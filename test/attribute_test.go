@@ -0,0 +1,84 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestAttributes(t *testing.T) {
+	g := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 3)
+
+	for _, name := range []string{"a", "b", "c"} {
+		g.AddNode(name)
+	}
+
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(0, 2)
+
+	now := time.Now()
+
+	if err := g.SetNodeAttribute(0, "created", now); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := g.SetNodeAttribute(0, "capacity", 12); err != nil {
+		t.Fatal(err)
+	}
+
+	attrs, err := g.NodeAttributes(0)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if created, ok := attrs.Time("created"); !ok || !created.Equal(now) {
+		t.Fatal("invalid time attribute")
+	}
+
+	if capacity, ok := attrs.Float("capacity"); !ok || capacity != 12 {
+		t.Fatal("invalid numeric attribute")
+	}
+
+	if _, err := g.NodeAttribute(1, "created"); err == nil {
+		t.Fatal("missing attribute should be reported")
+	}
+
+	// Undirected edge attributes are visible from both directions.
+	if err := g.SetEdgeAttribute(0, 2, "latency", 5.0); err != nil {
+		t.Fatal(err)
+	}
+
+	if latency, err := g.EdgeAttribute(2, 0, "latency"); err != nil || latency != 5.0 {
+		t.Fatalf("invalid edge attribute: %v, %v", latency, err)
+	}
+
+	u := g.ToUnit()
+
+	if p := u.ShortestPath(0, 2); p.Distance() != 1 {
+		t.Fatalf("invalid unweighted path: %v", p)
+	}
+
+	// Selecting the attribute as the weight makes the detour through node 1 shorter.
+	u.SetWeightAttribute("latency")
+
+	if p := u.ShortestPath(0, 2); p.Distance() != 2 || len(p.Nodes()) != 3 {
+		t.Fatalf("invalid attribute-weighted path: %v", p)
+	}
+
+	if err := g.RemoveEdgeAttribute(0, 2, "latency"); err != nil {
+		t.Fatal(err)
+	}
+
+	if p := u.ShortestPath(0, 2); p.Distance() != 1 {
+		t.Fatalf("removed attribute should not be used: %v", p)
+	}
+
+	g.RemoveNode(0)
+
+	if _, err := g.NodeAttributes(0); err == nil {
+		t.Fatal("attributes of a removed node should be gone")
+	}
+}
@@ -0,0 +1,99 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestBuilder(t *testing.T) {
+	b := netrics.NewBuilder(netrics.UNDIRECTED_WEIGHTED)
+	b.Grow(4, 5)
+
+	if first := b.AddNodes("a", "b"); first != 0 {
+		t.Fatalf("unexpected first identifier: %d", first)
+	}
+
+	if first := b.AddNodes("c", "d"); first != 2 {
+		t.Fatalf("unexpected first identifier: %d", first)
+	}
+
+	b.AddEdges(
+		netrics.Edge{From: 0, To: 1, Distance: 1},
+		netrics.Edge{From: 1, To: 0, Distance: 7}, // The same undirected edge; the first one wins.
+		netrics.Edge{From: 1, To: 2, Distance: 2},
+	)
+	b.AddEdges(
+		netrics.Edge{From: 2, To: 3, Distance: 3},
+		netrics.Edge{From: 3, To: 9, Distance: 1}, // Unknown endpoint, skipped.
+	)
+
+	// The skipped edge is reported alongside the graph.
+	g, err := b.Build()
+	var missing *netrics.NotExistNodeError
+
+	if g == nil || !errors.As(err, &missing) || missing.ID != 9 {
+		t.Fatalf("the skipped edge should be reported: %v, %v", g, err)
+	}
+
+	if g.NodeCount() != 4 || g.EdgeCount() != 3 {
+		t.Fatalf("unexpected size: %d nodes, %d edges", g.NodeCount(), g.EdgeCount())
+	}
+
+	if d, _ := g.FindEdge(1, 0); *d != 1 {
+		t.Fatalf("duplicate edge should keep the first distance, got %v", *d)
+	}
+
+	if in, _ := g.InNeighbors(2); len(in) != 2 {
+		t.Fatalf("unexpected in-neighbors: %v", in)
+	}
+
+	if p := g.ToUnit().ShortestPath(0, 3); p.Distance() != 6 {
+		t.Fatalf("unexpected shortest path: %v", p)
+	}
+
+	// The built graph accepts further changes like any other graph.
+	if node, err := g.AddNode("e"); err != nil || node.ID() != 4 {
+		t.Fatalf("unexpected node after build: %v, %v", node, err)
+	}
+
+	if id, err := g.InsertEdge(3, 4, 1); err != nil || id != 3 {
+		t.Fatalf("unexpected edge after build: %d, %v", id, err)
+	}
+
+	// Strict mode reports every problem together.
+	s := netrics.NewBuilder(netrics.DIRECTED_UNWEIGHTED, netrics.UNIQUE_NAMES)
+	s.SetStrict(true)
+	s.AddNodes("x", "y", "x")
+	s.AddEdges(netrics.Edge{From: 0, To: 0}, netrics.Edge{From: 0, To: 5}, netrics.Edge{From: 0, To: 1})
+
+	if g, err := s.Build(); g != nil || !errors.Is(err, netrics.ErrAlreadyNode) ||
+		!errors.Is(err, netrics.ErrSelfEdge) || !errors.Is(err, netrics.ErrNotExistNode) {
+		t.Fatalf("expected every problem to be reported: %v", err)
+	}
+
+	// Repeated names break UNIQUE_NAMES, which the graph cannot drop, so they fail the build even without strict mode.
+	s.SetStrict(false)
+
+	if g, err := s.Build(); g != nil || !errors.Is(err, netrics.ErrAlreadyNode) {
+		t.Fatalf("repeated names should fail the build: %v, %v", g, err)
+	}
+
+	// With unique names, the same edges build a graph without the invalid ones, which are reported.
+	l := netrics.NewBuilder(netrics.DIRECTED_UNWEIGHTED, netrics.UNIQUE_NAMES)
+	l.AddNodes("x", "y")
+	l.AddEdges(netrics.Edge{From: 0, To: 0}, netrics.Edge{From: 0, To: 5}, netrics.Edge{From: 0, To: 1})
+
+	g, err = l.Build()
+
+	if g == nil || g.EdgeCount() != 1 || !g.HasOption(netrics.UNIQUE_NAMES) ||
+		!errors.Is(err, netrics.ErrSelfEdge) || !errors.Is(err, netrics.ErrNotExistNode) {
+		t.Fatalf("unexpected lenient build: %v, %v", g, err)
+	}
+
+	// A build without problems returns no error.
+	if _, err := netrics.NewBuilder(netrics.DIRECTED_UNWEIGHTED).Build(); err != nil {
+		t.Fatal(err)
+	}
+}
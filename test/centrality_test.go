@@ -0,0 +1,303 @@
+package test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestBetweennessCentrality(t *testing.T) {
+	// A square 0 - 1 - 2 - 3 - 0 has two shortest paths between opposite corners.
+	g := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 4)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		g.AddNode(name)
+	}
+
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {1, 2}, {2, 3}, {3, 0}} {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	u := g.ToUnit()
+	pu := g.ToParallelUnit(4)
+
+	raw := u.BetweennessCentralityWithOptions(false, netrics.NORMALIZE_NONE)
+	normalized := pu.BetweennessCentrality()
+	endpoints := pu.BetweennessCentralityWithOptions(true, netrics.NORMALIZE_NONE)
+
+	t.Logf("\nBetweennessCentrality: %v\n", spew.Sdump(normalized))
+
+	for id := netrics.NodeID(0); id < 4; id++ {
+		if math.Abs(raw[id]-0.5) > 1e-9 {
+			t.Fatalf("invalid raw betweenness of %d: %f", id, raw[id])
+		}
+
+		if math.Abs(normalized[id]-1.0/6) > 1e-9 {
+			t.Fatalf("invalid normalized betweenness of %d: %f", id, normalized[id])
+		}
+
+		if math.Abs(endpoints[id]-3.5) > 1e-9 {
+			t.Fatalf("invalid endpoint betweenness of %d: %f", id, endpoints[id])
+		}
+	}
+
+	// A weighted diamond 0 -> {1, 2} -> 3 with equal total weights splits the pair (0, 3).
+	d := netrics.NewGraph(netrics.DIRECTED_WEIGHTED, 4)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		d.AddNode(name)
+	}
+
+	d.AddWeightEdge(0, 1, 0.5)
+	d.AddWeightEdge(1, 3, 1.5)
+	d.AddWeightEdge(0, 2, 1.5)
+	d.AddWeightEdge(2, 3, 0.5)
+
+	for _, scores := range []map[netrics.NodeID]float64{
+		d.ToUnit().BetweennessCentralityWithOptions(false, netrics.NORMALIZE_NONE),
+		d.ToParallelUnit(2).BetweennessCentralityWithOptions(false, netrics.NORMALIZE_NONE),
+	} {
+		if scores[0] != 0 || scores[1] != 0.5 || scores[2] != 0.5 || scores[3] != 0 {
+			t.Fatalf("invalid weighted betweenness: %v", scores)
+		}
+	}
+
+	// A zero-weight edge 2 -> 1 ties the path 0 -> 2 -> 1 with the edge 0 -> 1 after 1 is settled, as it comes first.
+	z := netrics.NewGraph(netrics.DIRECTED_WEIGHTED, 3)
+
+	for _, name := range []string{"s", "w", "v"} {
+		z.AddNode(name)
+	}
+
+	z.AddWeightEdge(0, 1, 1)
+	z.AddWeightEdge(0, 2, 1)
+	z.AddWeightEdge(2, 1, 0)
+
+	for _, scores := range []map[netrics.NodeID]float64{
+		z.ToUnit().BetweennessCentralityWithOptions(false, netrics.NORMALIZE_NONE),
+		z.ToParallelUnit(2).BetweennessCentralityWithOptions(false, netrics.NORMALIZE_NONE),
+	} {
+		if scores[0] != 0 || scores[1] != 0 || scores[2] != 0.5 {
+			t.Fatalf("invalid betweenness with a zero-weight tie: %v", scores)
+		}
+	}
+}
+
+func TestEdgeBetweennessCentrality(t *testing.T) {
+	// Two triangles joined by the bridge 2 - 3.
+	g := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 6)
+
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		g.AddNode(name)
+	}
+
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}} {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	raw := g.ToUnit().EdgeBetweennessCentrality(netrics.NORMALIZE_NONE)
+	scaled := g.ToParallelUnit(4).EdgeBetweennessCentrality(netrics.NORMALIZE_MAX)
+
+	t.Logf("\nEdgeBetweennessCentrality: %v\n", spew.Sdump(raw))
+
+	if len(raw) != 7 || len(scaled) != 7 {
+		t.Fatalf("invalid edge count: %d, %d", len(raw), len(scaled))
+	}
+
+	// Every pair across the bridge uses it: 3 * 3 pairs.
+	if bridge := (netrics.EdgeKey{From: 2, To: 3}); raw[bridge] != 9 || scaled[bridge] != 1 {
+		t.Fatalf("invalid bridge betweenness: %f, %f", raw[bridge], scaled[bridge])
+	}
+
+	if edge := (netrics.EdgeKey{From: 0, To: 1}); raw[edge] != 1 {
+		t.Fatalf("invalid edge betweenness: %f", raw[edge])
+	}
+}
+
+func TestClosenessCentrality(t *testing.T) {
+	// Directed chain 0 -> 1 -> 2 and an isolated node 3.
+	g := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 4)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		g.AddNode(name)
+	}
+
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+
+	u := g.ToUnit()
+	pu := g.ToParallelUnit(4)
+
+	plain := u.ClosenessCentrality(false)
+	improved := pu.ClosenessCentrality(true)
+	harmonic := pu.HarmonicCentrality()
+
+	t.Logf("\nClosenessCentrality: %v\n", spew.Sdump(improved))
+
+	if plain[0] != 0 || plain[1] != 1 || math.Abs(plain[2]-2.0/3) > 1e-9 || plain[3] != 0 {
+		t.Fatalf("invalid closeness: %v", plain)
+	}
+
+	if math.Abs(improved[1]-1.0/3) > 1e-9 || math.Abs(improved[2]-4.0/9) > 1e-9 {
+		t.Fatalf("invalid improved closeness: %v", improved)
+	}
+
+	if math.Abs(harmonic[2]-0.5) > 1e-9 || harmonic[0] != 0 || len(u.HarmonicCentrality()) != 4 {
+		t.Fatalf("invalid harmonic centrality: %v", harmonic)
+	}
+}
+
+func TestPageRank(t *testing.T) {
+	// A directed cycle 0 -> 1 -> 2 -> 0 plus the dangling node 3 reached from 0.
+	g := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 4)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		g.AddNode(name)
+	}
+
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {1, 2}, {2, 0}, {0, 3}} {
+		g.AddEdge(e[0], e[1])
+	}
+
+	rank, converged, err := g.ToUnit().PageRank(0.85, 1000, 1e-10, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parallel, parallelConverged, _ := g.ToParallelUnit(4).PageRank(0.85, 1000, 1e-10, nil)
+
+	t.Logf("\nPageRank: %v\n", spew.Sdump(rank))
+
+	if !converged || !parallelConverged {
+		t.Fatal("pagerank should converge")
+	}
+
+	sum := 0.0
+
+	for id, value := range rank {
+		sum += value
+
+		if math.Abs(value-parallel[id]) > 1e-9 {
+			t.Fatalf("sequential and parallel ranks differ: %v, %v", rank, parallel)
+		}
+	}
+
+	if math.Abs(sum-1) > 1e-9 || rank[0] <= rank[3] {
+		t.Fatalf("invalid pagerank: %v", rank)
+	}
+
+	personalized, _, _ := g.ToUnit().PageRank(0.85, 1000, 1e-10, map[netrics.NodeID]float64{3: 1})
+
+	if personalized[3] <= rank[3] {
+		t.Fatalf("personalization should favour node 3: %v", personalized)
+	}
+
+	if _, converged, err := g.ToUnit().PageRank(0.85, 1, 1e-10, nil); converged || err != nil {
+		t.Fatalf("a single iteration should not converge: %v", err)
+	}
+
+	// Invalid parameters are reported as errors rather than as a failure to converge.
+	for _, invalid := range []struct {
+		damping         float64
+		personalization map[netrics.NodeID]float64
+	}{
+		{1.5, nil},
+		{math.NaN(), nil},
+		{0.85, map[netrics.NodeID]float64{0: -1, 1: 2}},
+		{0.85, map[netrics.NodeID]float64{9: 1}},
+	} {
+		var parameter *netrics.InvalidParameterError
+
+		if _, _, err := g.ToUnit().PageRank(invalid.damping, 1000, 1e-10, invalid.personalization); !errors.As(err, &parameter) {
+			t.Fatalf("expected InvalidParameterError for %v, got %v", invalid, err)
+		}
+	}
+}
+
+func TestKatzCentralityAndHITS(t *testing.T) {
+	// A directed cycle 0 -> 1 -> 2 -> 0 has a spectral radius of 1.
+	g := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 3)
+
+	for _, name := range []string{"a", "b", "c"} {
+		g.AddNode(name)
+	}
+
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {1, 2}, {2, 0}} {
+		g.AddEdge(e[0], e[1])
+	}
+
+	katz, err := g.ToUnit().KatzCentrality(0.5, 1, 1000, 1e-9)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("\nKatzCentrality: %v\n", spew.Sdump(katz))
+
+	for _, value := range katz {
+		if math.Abs(value-1/math.Sqrt(3)) > 1e-6 {
+			t.Fatalf("invalid katz centrality: %v", katz)
+		}
+	}
+
+	if _, err := g.ToParallelUnit(2).KatzCentrality(1.5, 1, 1000, 1e-9); err == nil {
+		t.Fatal("alpha above 1/λ should be rejected")
+	}
+
+	if _, err := g.ToUnit().KatzCentrality(0.5, 1, 2, 1e-9); err == nil {
+		t.Fatal("non-convergence should be reported")
+	}
+
+	// A citation chain 0 -> 1 -> 2 -> 3 is acyclic, so its spectral radius is 0 and any alpha is accepted.
+	c := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 4)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		c.AddNode(name)
+	}
+
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {1, 2}, {2, 3}} {
+		c.AddEdge(e[0], e[1])
+	}
+
+	for _, alpha := range []float64{0.5, 10} {
+		chain, err := c.ToParallelUnit(2).KatzCentrality(alpha, 1, 1000, 1e-9)
+
+		if err != nil {
+			t.Fatalf("katz centrality should converge on a DAG: %v", err)
+		}
+
+		if !(chain[0] < chain[1] && chain[1] < chain[2] && chain[2] < chain[3]) {
+			t.Fatalf("invalid katz centrality on a DAG: %v", chain)
+		}
+	}
+
+	// A star 0 -> 1, 0 -> 2 has a single hub and two authorities.
+	s := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 3)
+
+	for _, name := range []string{"a", "b", "c"} {
+		s.AddNode(name)
+	}
+
+	s.AddEdge(0, 1)
+	s.AddEdge(0, 2)
+
+	hubs, authorities, err := s.ToParallelUnit(2).HITS(1000, 1e-9)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("\nHITS: %v\n", spew.Sdump(hubs, authorities))
+
+	if math.Abs(hubs[0]-1) > 1e-9 || math.Abs(authorities[1]-0.5) > 1e-9 || authorities[0] != 0 {
+		t.Fatalf("invalid hits scores: %v, %v", hubs, authorities)
+	}
+}
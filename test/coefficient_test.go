@@ -0,0 +1,59 @@
+package test
+
+import (
+	"math"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestSparseCoefficients(t *testing.T) {
+	// A triangle 0 - 1 - 2 with a pendant 3 on node 2; node 4 is removed afterwards.
+	g := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 5)
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		g.AddNode(name)
+	}
+
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}} {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := g.RemoveNode(4); err != nil {
+		t.Fatal(err)
+	}
+
+	u := g.ToUnit()
+	pu := g.ToParallelUnit(4)
+
+	type coefficients interface {
+		ClusteringCoefficient() (map[netrics.NodeID]float64, float64)
+		DegreeCentrality() map[netrics.NodeID]float64
+	}
+
+	for _, unit := range []coefficients{u, pu} {
+		local, global := unit.ClusteringCoefficient()
+
+		if len(local) != 4 {
+			t.Fatalf("removed node should not be scored: %v", local)
+		}
+
+		if local[0] != 1 || math.Abs(local[2]-1.0/3) > 1e-9 || local[3] != 0 {
+			t.Fatalf("invalid local clustering: %v", local)
+		}
+
+		if math.Abs(global-(2+1.0/3)/4) > 1e-9 {
+			t.Fatalf("invalid global clustering: %f", global)
+		}
+
+		if degree := unit.DegreeCentrality(); math.Abs(degree[2]-1) > 1e-9 || len(degree) != 4 {
+			t.Fatalf("invalid degree centrality: %v", degree)
+		}
+	}
+
+	if u.RichClubCoefficient(2) != 1 || pu.RichClubCoefficient(2) != 1 {
+		t.Fatal("invalid rich club coefficient")
+	}
+}
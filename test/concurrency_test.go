@@ -0,0 +1,107 @@
+package test
+
+import (
+	"sync"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestConcurrentMutation(t *testing.T) {
+	const n = 200
+
+	g := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, n)
+
+	for i := 0; i < n; i++ {
+		g.AddNode("node")
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	// Ingest edges from one goroutine while another keeps running algorithms on the same graph.
+	go func() {
+		defer wg.Done()
+
+		for i := 1; i < n; i++ {
+			if err := g.AddEdge(netrics.NodeID(i-1), netrics.NodeID(i)); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		pu := g.ToParallelUnit(4)
+
+		for i := 0; i < 20; i++ {
+			pu.DegreeCentrality()
+			pu.AverageShortestPathLength()
+			g.EdgeCount()
+		}
+	}()
+
+	wg.Wait()
+
+	if g.EdgeCount() != n-1 {
+		t.Fatalf("invalid edge count: %d", g.EdgeCount())
+	}
+
+	// A unit computed before the last modification must notice the new version.
+	u := g.ToUnit()
+	before := u.AverageShortestPathLength()
+	version := g.Version()
+
+	g.RemoveEdge(n-2, n-1)
+
+	if g.Version() == version {
+		t.Fatal("version should change on modification")
+	}
+
+	if after := u.AverageShortestPathLength(); after == before {
+		t.Fatalf("stale shortest paths after modification: %f", after)
+	}
+}
+
+func TestConcurrentSetOperations(t *testing.T) {
+	first := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 50)
+	second := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 50)
+
+	for i := 0; i < 50; i++ {
+		first.AddNode("node")
+		second.AddNode("node")
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+
+	// Both graphs are combined in opposite roles while one of them is being modified.
+	go func() {
+		defer wg.Done()
+
+		for i := 1; i < 50; i++ {
+			second.AddEdge(netrics.NodeID(i-1), netrics.NodeID(i))
+		}
+	}()
+
+	for _, pair := range [][2]netrics.Graph{{first, second}, {second, first}} {
+		go func(a, b netrics.Graph) {
+			defer wg.Done()
+
+			for i := 0; i < 20; i++ {
+				if _, _, err := a.Union(b, nil, netrics.MERGE_MIN); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(pair[0], pair[1])
+	}
+
+	wg.Wait()
+
+	if union, _, err := second.Union(second, nil, netrics.MERGE_MIN); err != nil || union.EdgeCount() != 49 {
+		t.Fatalf("a graph combined with itself should keep its edges: %v", err)
+	}
+}
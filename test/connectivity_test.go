@@ -0,0 +1,137 @@
+package test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestConnectivity(t *testing.T) {
+	g := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 6)
+
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		g.AddNode(name)
+	}
+
+	// Cycle a -> b -> c -> a, edge c -> d, cycle d <-> e, isolated f.
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 3}} {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	u := g.ToUnit()
+	pu := g.ToParallelUnit(4)
+
+	weak := u.WeaklyConnectedComponents()
+	strong := pu.StronglyConnectedComponents()
+
+	t.Logf("\nWeaklyConnectedComponents: %v\n", spew.Sdump(weak))
+	t.Logf("\nStronglyConnectedComponents: %v\n", spew.Sdump(strong))
+
+	if u.ComponentCount(false) != 2 || pu.ComponentCount(false) != 2 {
+		t.Fatal("invalid weak component count")
+	}
+
+	if u.ComponentCount(true) != 3 || pu.ComponentCount(true) != 3 {
+		t.Fatal("invalid strong component count")
+	}
+
+	if strong[0] != strong[1] || strong[1] != strong[2] || strong[2] == strong[3] || strong[3] != strong[4] {
+		t.Fatal("invalid strong components")
+	}
+
+	if largest := u.LargestComponent(true); len(largest) != 3 || largest[0] != 0 {
+		t.Fatalf("invalid largest component: %v", largest)
+	}
+
+	if u.IsConnected(false) || pu.IsConnected(true) {
+		t.Fatal("graph should not be connected")
+	}
+
+	g.RemoveNode(5)
+
+	if !g.ToUnit().IsConnected(false) {
+		t.Fatal("graph should be weakly connected")
+	}
+}
+
+func TestReverseIndex(t *testing.T) {
+	const n = 40
+
+	g := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, n)
+	rng := rand.New(rand.NewSource(7))
+
+	for i := 0; i < n; i++ {
+		g.AddNode("node")
+	}
+
+	for i := 0; i < 2*n; i++ {
+		g.AddEdge(netrics.NodeID(rng.Intn(n)), netrics.NodeID(rng.Intn(n)))
+	}
+
+	g.RemoveNode(3)
+	g.RemoveEdge(5, 6)
+
+	// The incoming-edge index must agree with the outgoing edges.
+	in := map[netrics.NodeID][]netrics.NodeID{}
+
+	g.Edges()(func(e netrics.Edge) bool {
+		in[e.To] = append(in[e.To], e.From)
+		return true
+	})
+
+	centrality := g.ToUnit().InDegreeCentrality()
+
+	g.Nodes()(func(node *netrics.Node) bool {
+		sources, _ := g.InNeighbors(node.ID())
+
+		if len(sources) != len(in[node.ID()]) {
+			t.Fatalf("invalid in-neighbors of %d: %v", node.ID(), sources)
+		}
+
+		if math.Abs(centrality[node.ID()]-float64(len(sources))/float64(n-2)) > 1e-9 {
+			t.Fatalf("invalid in-degree centrality of %d: %f", node.ID(), centrality[node.ID()])
+		}
+
+		return true
+	})
+
+	// Two nodes share a strong component exactly when each reaches the other.
+	reach := func(from netrics.NodeID) map[netrics.NodeID]bool {
+		seen := map[netrics.NodeID]bool{from: true}
+		queue := []netrics.NodeID{from}
+
+		for len(queue) > 0 {
+			next, _ := g.Neighbors(queue[0])
+			queue = queue[1:]
+
+			for _, w := range next {
+				if !seen[w] {
+					seen[w] = true
+					queue = append(queue, w)
+				}
+			}
+		}
+
+		return seen
+	}
+
+	strong := g.ToParallelUnit(4).StronglyConnectedComponents()
+	reachable := map[netrics.NodeID]map[netrics.NodeID]bool{}
+
+	for id := range strong {
+		reachable[id] = reach(id)
+	}
+
+	for a := range strong {
+		for b := range strong {
+			if (strong[a] == strong[b]) != (reachable[a][b] && reachable[b][a]) {
+				t.Fatalf("invalid strong components for %d and %d", a, b)
+			}
+		}
+	}
+}
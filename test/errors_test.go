@@ -0,0 +1,72 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestTypedErrors(t *testing.T) {
+	g := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 3)
+
+	for _, name := range []string{"a", "b", "c"} {
+		g.AddNode(name)
+	}
+
+	g.AddEdge(0, 1)
+
+	err := g.AddEdge(0, 7)
+	var missing *netrics.NotExistNodeError
+
+	if !errors.Is(err, netrics.ErrNotExistNode) || !errors.As(err, &missing) || missing.ID != 7 {
+		t.Fatalf("unexpected error for a missing node: %v", err)
+	}
+
+	if err.Error() != "node not exist: [7]" {
+		t.Fatalf("unexpected message: %s", err)
+	}
+
+	err = g.AddEdge(0, 1)
+	var already *netrics.AlreadyEdgeError
+
+	if !errors.Is(err, netrics.ErrAlreadyEdge) || !errors.As(err, &already) || already.From != 0 || already.To != 1 {
+		t.Fatalf("unexpected error for a duplicate edge: %v", err)
+	}
+
+	if err := g.RemoveEdge(1, 2); !errors.Is(err, netrics.ErrNotExistEdge) {
+		t.Fatalf("unexpected error for a missing edge: %v", err)
+	}
+
+	if err := g.AddEdge(2, 2); !errors.Is(err, netrics.ErrSelfEdge) {
+		t.Fatalf("unexpected error for a self edge: %v", err)
+	}
+
+	var invalid *netrics.InvalidEdgeError
+
+	if err := g.AddWeightEdge(1, 2, 3); !errors.As(err, &invalid) || invalid.Distance != 3 {
+		t.Fatalf("unexpected error for an invalid weight: %v", err)
+	}
+
+	var attribute *netrics.NotExistAttributeError
+
+	if _, err := g.EdgeAttribute(0, 1, "capacity"); !errors.As(err, &attribute) || attribute.Key != "capacity" {
+		t.Fatalf("unexpected error for a missing attribute: %v", err)
+	}
+
+	if _, err := g.FindNodesByName("z"); !errors.Is(err, netrics.ErrNotExistNode) {
+		t.Fatalf("unexpected error for a missing name: %v", err)
+	}
+
+	var readOnly *netrics.ReadOnlyError
+
+	if err := g.Snapshot().RemoveNode(0); !errors.As(err, &readOnly) || !errors.Is(err, netrics.ErrReadOnly) {
+		t.Fatalf("unexpected error for a read-only graph: %v", err)
+	}
+
+	var parameter *netrics.InvalidParameterError
+
+	if _, err := g.ToUnit().KatzCentrality(-1, 1, 100, 1e-9); !errors.As(err, &parameter) || parameter.Parameter != "alpha" {
+		t.Fatalf("unexpected error for an invalid parameter: %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package test
+
+import (
+	"math"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestStableNodeIDs(t *testing.T) {
+	// A directed path a -> b -> c -> d -> e; b is removed, leaving a gap in the identifiers.
+	g := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 5)
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		g.AddNode(name)
+	}
+
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 0}} {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := g.RemoveNode(1); err != nil {
+		t.Fatal(err)
+	}
+
+	if g.EdgeCount() != 3 {
+		t.Fatalf("incoming edges of a removed node should be removed: %d edges", g.EdgeCount())
+	}
+
+	if _, err := g.FindEdge(0, 1); err == nil {
+		t.Fatal("edge into the removed node still exists")
+	}
+
+	index := g.Index()
+
+	if index.Len() != 4 || index.ID(3) != 4 {
+		t.Fatalf("invalid index: %v", index.IDs())
+	}
+
+	if _, ok := index.Position(1); ok {
+		t.Fatal("removed node should not have a position")
+	}
+
+	// Path c -> d -> e -> a: d and e lie on the paths between the remaining pairs.
+	betweenness := g.ToUnit().BetweennessCentralityWithOptions(false, netrics.NORMALIZE_NONE)
+	pagerank, _, _ := g.ToParallelUnit(4).PageRank(0.85, 100, 1e-12, nil)
+
+	if len(betweenness) != 4 || len(pagerank) != 4 {
+		t.Fatalf("every live node should be scored: %v %v", betweenness, pagerank)
+	}
+
+	if betweenness[3] != 2 || betweenness[4] != 2 || betweenness[0] != 0 {
+		t.Fatalf("invalid betweenness after removal: %v", betweenness)
+	}
+
+	if _, ok := betweenness[1]; ok {
+		t.Fatal("removed node should not be scored")
+	}
+
+	// Compact renumbers c, d, e to 1, 2, 3 and keeps the edges.
+	mapping, err := g.Compact()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(mapping) != 4 || mapping[0] != 0 || mapping[2] != 1 || mapping[4] != 3 {
+		t.Fatalf("invalid mapping: %v", mapping)
+	}
+
+	if old, ok := mapping.Inverse().Translate(3); !ok || old != 4 {
+		t.Fatalf("invalid inverse mapping: %v", mapping.Inverse())
+	}
+
+	if _, err := g.FindEdge(3, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if nodes, err := g.FindNodesByName("e"); err != nil || nodes[0].ID() != 3 {
+		t.Fatal("node names should follow the new identifiers")
+	}
+
+	compacted := g.ToUnit().BetweennessCentralityWithOptions(false, netrics.NORMALIZE_NONE)
+
+	for old, id := range mapping {
+		if math.Abs(compacted[id]-betweenness[old]) > 1e-9 {
+			t.Fatalf("scores changed after compaction: %v", compacted)
+		}
+	}
+
+	if node, _ := g.AddNode("f"); node.ID() != 4 {
+		t.Fatalf("next identifier should follow the compacted range: %d", node.ID())
+	}
+}
@@ -0,0 +1,155 @@
+package test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestMultigraph(t *testing.T) {
+	g := netrics.NewGraph(netrics.UNDIRECTED_WEIGHTED, 3, netrics.MULTIGRAPH)
+
+	for _, name := range []string{"a", "b", "c"} {
+		g.AddNode(name)
+	}
+
+	first, _ := g.InsertEdge(0, 1, 5)
+	second, err := g.InsertEdge(1, 0, 2)
+
+	if err != nil {
+		t.Fatalf("parallel edge rejected: %v", err)
+	}
+
+	g.AddWeightEdge(1, 2, 4)
+
+	if g.EdgeCount() != 3 || first == second {
+		t.Fatalf("unexpected edges: %d, ids %d and %d", g.EdgeCount(), first, second)
+	}
+
+	edges, err := g.FindEdges(0, 1)
+
+	if err != nil || len(edges) != 2 || edges[0].ID != first || edges[1].Distance != 2 {
+		t.Fatalf("unexpected parallel edges: %v, %v", edges, err)
+	}
+
+	if neighbors, _ := g.Neighbors(1); len(neighbors) != 2 {
+		t.Fatalf("neighbors should be listed once: %v", neighbors)
+	}
+
+	if degree, _ := g.OutDegree(1); degree != 3 {
+		t.Fatalf("degree should count parallel edges, got %d", degree)
+	}
+
+	// Attributes can be set on each parallel edge, from either direction of an undirected edge.
+	if err := g.SetEdgeAttributeByID(1, 0, second, "line", "backup"); err != nil {
+		t.Fatal(err)
+	}
+
+	g.SetEdgeAttribute(0, 1, "line", "main")
+
+	if v, err := g.EdgeAttributeByID(0, 1, second, "line"); err != nil || v != "backup" {
+		t.Fatalf("unexpected attribute of the second edge: %v, %v", v, err)
+	}
+
+	if attributes, _ := g.EdgeAttributesByID(1, 0, first); attributes["line"] != "main" {
+		t.Fatalf("unexpected attributes of the first edge: %v", attributes)
+	}
+
+	if err := g.RemoveEdgeAttributeByID(0, 1, second, "line"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := g.EdgeAttributeByID(1, 0, second, "line"); !errors.Is(err, netrics.ErrNotExistAttribute) {
+		t.Fatalf("attribute should be removed from both directions: %v", err)
+	}
+
+	if v, _ := g.EdgeAttribute(0, 1, "line"); v != "main" {
+		t.Fatalf("the first edge should keep its attribute: %v", v)
+	}
+
+	if _, err := g.EdgeAttributeByID(1, 2, first, "line"); !errors.Is(err, netrics.ErrNotExistEdge) {
+		t.Fatalf("expected ErrNotExistEdge, got %v", err)
+	}
+
+	// Shortest paths follow the lightest parallel edge.
+	u := g.ToUnit()
+
+	if p := u.ShortestPath(0, 2); p.Distance() != 6 {
+		t.Fatalf("expected distance 6, got %v", p.Distance())
+	}
+
+	for policy, want := range map[netrics.Collapse]float64{
+		netrics.COLLAPSE_MIN:   1,   // Two distinct neighbors out of two.
+		netrics.COLLAPSE_COUNT: 1.5, // Three edges.
+		netrics.COLLAPSE_SUM:   5.5, // 5 + 2 + 4.
+	} {
+		u.SetCollapse(policy)
+
+		if got := u.DegreeCentrality()[1]; math.Abs(got-want) > 1e-9 {
+			t.Fatalf("%v: degree centrality %v, want %v", policy, got, want)
+		}
+	}
+
+	if err := g.RemoveEdgeByID(1, 0, first); err != nil {
+		t.Fatal(err)
+	}
+
+	if d, _ := g.FindEdge(0, 1); *d != 2 || g.EdgeCount() != 2 {
+		t.Fatalf("wrong edge removed: %v", *d)
+	}
+
+	if err := g.RemoveEdgeByID(0, 1, first); !errors.Is(err, netrics.ErrNotExistEdge) {
+		t.Fatalf("expected ErrNotExistEdge, got %v", err)
+	}
+
+	g.InsertEdge(0, 1, 3)
+
+	if err := g.RemoveEdge(0, 1); err != nil || g.EdgeCount() != 1 {
+		t.Fatalf("RemoveEdge should remove every parallel edge: %v, %d left", err, g.EdgeCount())
+	}
+
+	// Simple graphs keep rejecting parallel edges.
+	simple := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 2)
+	simple.AddNode("a")
+	simple.AddNode("b")
+	simple.AddEdge(0, 1)
+
+	if err := simple.AddEdge(0, 1); !errors.Is(err, netrics.ErrAlreadyEdge) {
+		t.Fatalf("expected ErrAlreadyEdge, got %v", err)
+	}
+}
+
+func TestMultigraphRemoveNode(t *testing.T) {
+	g := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 3, netrics.MULTIGRAPH)
+
+	for _, name := range []string{"a", "b", "c"} {
+		g.AddNode(name)
+	}
+
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {0, 1}, {2, 1}, {1, 2}, {1, 2}} {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if in, _ := g.InDegree(1); in != 3 {
+		t.Fatalf("expected in-degree 3, got %d", in)
+	}
+
+	u := g.ToUnit()
+	u.SetCollapse(netrics.COLLAPSE_COUNT)
+
+	if got := u.InDegreeCentrality()[1]; got != 1.5 {
+		t.Fatalf("expected in-degree centrality 1.5, got %v", got)
+	}
+
+	if err := g.RemoveNode(1); err != nil {
+		t.Fatal(err)
+	}
+
+	if g.EdgeCount() != 0 {
+		t.Fatalf("expected no edges left, got %d", g.EdgeCount())
+	}
+}
@@ -0,0 +1,194 @@
+package test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestNegativeWeights(t *testing.T) {
+	u := netrics.NewGraph(netrics.UNDIRECTED_WEIGHTED, 2)
+	u.AddNode("a")
+	u.AddNode("b")
+
+	if err := u.AddWeightEdge(0, 1, -1); !errors.Is(err, netrics.ErrInvalidEdge) {
+		t.Fatalf("negative weight should be rejected in undirected graphs: %v", err)
+	}
+
+	g := netrics.NewGraph(netrics.DIRECTED_WEIGHTED, 4)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		g.AddNode(name)
+	}
+
+	// The cheapest route from 0 to 3 takes the rebate on 1->2.
+	g.AddWeightEdge(0, 1, 4)
+	g.AddWeightEdge(0, 2, 2)
+	g.AddWeightEdge(1, 2, -3)
+	g.AddWeightEdge(2, 3, 1)
+
+	unit := g.ToUnit()
+	paths, err := unit.BellmanFord(0)
+
+	if err != nil || len(paths) != 4 || paths[0].Distance() != 0 {
+		t.Fatalf("unexpected Bellman-Ford result: %v, %v", paths, err)
+	}
+
+	if p := paths[3]; p.Distance() != 2 || len(p.Nodes()) != 4 {
+		t.Fatalf("unexpected path to 3: %v", p)
+	}
+
+	// The path cache reweights the graph with Johnson's algorithm and keeps the original distances.
+	pu := g.ToParallelUnit(2)
+
+	for _, p := range []netrics.Path{unit.ShortestPath(0, 3), pu.ShortestPath(0, 3)} {
+		if p.Distance() != 2 || len(p.Nodes()) != 4 {
+			t.Fatalf("unexpected cached path: %v", p)
+		}
+	}
+
+	for _, p := range []netrics.Path{unit.ShortestPath(1, 3), pu.ShortestPath(1, 3)} {
+		if p.Distance() != -2 {
+			t.Fatalf("unexpected cached distance: %v", p.Distance())
+		}
+	}
+
+	if unit.PathError() != nil || pu.PathError() != nil {
+		t.Fatalf("unexpected path errors: %v, %v", unit.PathError(), pu.PathError())
+	}
+
+	// Node 2 is reached at distances 1 and -3, which sum to less than zero, and node 3 at 2, -2 and 1.
+	if c := unit.ClosenessCentrality(false); c[1] != 0.25 || c[2] != 0 || c[3] != 3 {
+		t.Fatalf("unexpected closeness with negative distances: %v", c)
+	}
+
+	if h := pu.HarmonicCentrality(); math.Abs(h[2]-1.0/3) > 1e-9 {
+		t.Fatalf("only positive distances should contribute to harmonic centrality: %v", h)
+	}
+
+	if _, err := unit.BellmanFord(9); !errors.Is(err, netrics.ErrNotExistNode) {
+		t.Fatalf("missing source should fail: %v", err)
+	}
+
+	// Closing 2->1 creates the cycle 1->2->1 of weight -3 + 1.
+	g.AddWeightEdge(2, 1, 1)
+
+	_, err = unit.BellmanFord(0)
+	var cycle *netrics.NegativeCycleError
+
+	if !errors.As(err, &cycle) || !errors.Is(err, netrics.ErrNegativeCycle) {
+		t.Fatalf("negative cycle not reported: %v", err)
+	}
+
+	if nodes := cycle.Cycle.Nodes(); len(nodes) != 3 || nodes[0] != nodes[2] || cycle.Cycle.Distance() != -2 {
+		t.Fatalf("unexpected cycle: %v", cycle.Cycle)
+	}
+
+	if err := unit.PathError(); !errors.Is(err, netrics.ErrNegativeCycle) {
+		t.Fatalf("path cache should report the cycle: %v", err)
+	}
+
+	if p := unit.ShortestPath(0, 3); p.Distance() != netrics.INF {
+		t.Fatalf("no path should exist with a negative cycle: %v", p)
+	}
+
+	if d := unit.Diameter(); d.Distance() != netrics.INF || len(d.Nodes()) != 0 {
+		t.Fatalf("no diameter should exist with a negative cycle: %v", d)
+	}
+
+	if l := unit.PercentileShortestPathLength(0.5); l != netrics.INF {
+		t.Fatalf("no percentile should exist with a negative cycle: %v", l)
+	}
+
+	if l := g.ToParallelUnit(2).PercentileShortestPathLength(1); l != netrics.INF {
+		t.Fatalf("no percentile should exist with a negative cycle: %v", l)
+	}
+
+	closeness, harmonic := unit.ClosenessCentrality(true), g.ToParallelUnit(2).HarmonicCentrality()
+
+	for id := netrics.NodeID(0); id < 4; id++ {
+		if closeness[id] != 0 || harmonic[id] != 0 {
+			t.Fatalf("no node should be close to another with a negative cycle: %v, %v", closeness, harmonic)
+		}
+	}
+
+	if b := unit.BetweennessCentralityWithOptions(true, netrics.NORMALIZE_NONE); b[1] != 0 || b[2] != 0 {
+		t.Fatalf("no node should lie on a shortest path with a negative cycle: %v", b)
+	}
+
+	if d := g.ToParallelUnit(2).Diameter(); d.Distance() != netrics.INF || len(d.Nodes()) != 0 {
+		t.Fatalf("no diameter should exist with a negative cycle: %v", d)
+	}
+}
+
+func TestNegativeBetweenness(t *testing.T) {
+	g := netrics.NewGraph(netrics.DIRECTED_WEIGHTED, 3)
+
+	for _, name := range []string{"a", "b", "c"} {
+		g.AddNode(name)
+	}
+
+	// The only shortest path from 0 to 1 goes through 2.
+	g.AddWeightEdge(0, 1, -5)
+	g.AddWeightEdge(0, 2, -10)
+	g.AddWeightEdge(2, 1, 0)
+
+	for _, scores := range []map[netrics.NodeID]float64{
+		g.ToUnit().BetweennessCentralityWithOptions(false, netrics.NORMALIZE_NONE),
+		g.ToParallelUnit(2).BetweennessCentralityWithOptions(false, netrics.NORMALIZE_NONE),
+	} {
+		if scores[0] != 0 || scores[1] != 0 || scores[2] != 1 {
+			t.Fatalf("unexpected betweenness: %v", scores)
+		}
+	}
+
+	for _, scores := range []map[netrics.EdgeKey]float64{
+		g.ToUnit().EdgeBetweennessCentrality(netrics.NORMALIZE_NONE),
+		g.ToParallelUnit(2).EdgeBetweennessCentrality(netrics.NORMALIZE_NONE),
+	} {
+		if scores[netrics.EdgeKey{From: 0, To: 2}] != 2 || scores[netrics.EdgeKey{From: 2, To: 1}] != 2 ||
+			scores[netrics.EdgeKey{From: 0, To: 1}] != 0 {
+			t.Fatalf("unexpected edge betweenness: %v", scores)
+		}
+	}
+
+	// Reweighting makes every shortest path edge weigh 0, so the paths 0 -> 1 and 0 -> 2 -> 1 must still tie.
+	tie := netrics.NewGraph(netrics.DIRECTED_WEIGHTED, 3)
+
+	for _, name := range []string{"s", "w", "v"} {
+		tie.AddNode(name)
+	}
+
+	tie.AddWeightEdge(0, 1, 1)
+	tie.AddWeightEdge(0, 2, 2)
+	tie.AddWeightEdge(2, 1, -1)
+
+	for _, scores := range []map[netrics.NodeID]float64{
+		tie.ToUnit().BetweennessCentralityWithOptions(false, netrics.NORMALIZE_NONE),
+		tie.ToParallelUnit(2).BetweennessCentralityWithOptions(false, netrics.NORMALIZE_NONE),
+	} {
+		if scores[0] != 0 || scores[1] != 0 || scores[2] != 0.5 {
+			t.Fatalf("unexpected betweenness with tied negative paths: %v", scores)
+		}
+	}
+}
+
+func TestNegativeEdgesExist(t *testing.T) {
+	g := netrics.NewGraph(netrics.DIRECTED_WEIGHTED, 3)
+
+	for _, name := range []string{"a", "b", "c"} {
+		g.AddNode(name)
+	}
+
+	// A transitive triangle whose edges all carry negative weights.
+	g.AddWeightEdge(0, 1, -1)
+	g.AddWeightEdge(1, 2, -1)
+	g.AddWeightEdge(0, 2, -1)
+
+	// Node 0 links to both 1 and 2, which are linked to each other.
+	if local, _ := g.ToUnit().ClusteringCoefficient(); local[0] != 1 {
+		t.Fatalf("negative edges should count as edges: %v", local)
+	}
+}
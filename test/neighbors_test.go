@@ -0,0 +1,86 @@
+package test
+
+import (
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestNeighborIteration(t *testing.T) {
+	d := netrics.NewGraph(netrics.DIRECTED_WEIGHTED, 4)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		d.AddNode(name)
+	}
+
+	d.AddWeightEdge(0, 2, 2)
+	d.AddWeightEdge(0, 1, 1)
+	d.AddWeightEdge(3, 1, 4)
+
+	if out, _ := d.Neighbors(0); len(out) != 2 || out[0] != 1 || out[1] != 2 {
+		t.Fatalf("invalid neighbors: %v", out)
+	}
+
+	if in, _ := d.InNeighbors(1); len(in) != 2 || in[0] != 0 || in[1] != 3 {
+		t.Fatalf("invalid in-neighbors: %v", in)
+	}
+
+	if degree, _ := d.OutDegree(1); degree != 0 {
+		t.Fatalf("invalid out-degree: %d", degree)
+	}
+
+	if degree, _ := d.InDegree(1); degree != 2 {
+		t.Fatalf("invalid in-degree: %d", degree)
+	}
+
+	if _, err := d.Neighbors(9); err == nil {
+		t.Fatal("missing node should fail")
+	}
+
+	edges := []netrics.Edge{}
+
+	d.Edges()(func(e netrics.Edge) bool {
+		edges = append(edges, e)
+		return true
+	})
+
+	if len(edges) != 3 || edges[0] != (netrics.Edge{ID: 1, From: 0, To: 1, Distance: 1}) || edges[2].From != 3 {
+		t.Fatalf("invalid edges: %v", edges)
+	}
+
+	// Undirected edges are visited once, and iteration stops when yield returns false.
+	u := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 3)
+
+	for _, name := range []string{"a", "b", "c"} {
+		u.AddNode(name)
+	}
+
+	u.AddEdge(2, 0)
+	u.AddEdge(1, 2)
+
+	count := 0
+
+	u.Edges()(func(e netrics.Edge) bool {
+		if e.From > e.To {
+			t.Fatalf("undirected edge not ordered: %v", e)
+		}
+
+		count++
+		return true
+	})
+
+	if count != 2 {
+		t.Fatalf("invalid undirected edge count: %d", count)
+	}
+
+	names := ""
+
+	u.Nodes()(func(n *netrics.Node) bool {
+		names += n.Name()
+		return n.ID() < 1
+	})
+
+	if names != "ab" {
+		t.Fatalf("invalid node iteration: %s", names)
+	}
+}
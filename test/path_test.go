@@ -0,0 +1,52 @@
+package test
+
+import (
+	"math"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestWeightedPath(t *testing.T) {
+	g := netrics.NewGraph(netrics.DIRECTED_WEIGHTED, 4)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		g.AddNode(name)
+	}
+
+	edges := []struct {
+		from, to netrics.NodeID
+		distance netrics.Distance
+	}{
+		{0, 1, 0.25}, {1, 2, 0.5}, {0, 2, 1.5}, {2, 3, 0.125},
+	}
+
+	for _, e := range edges {
+		if err := g.AddWeightEdge(e.from, e.to, e.distance); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := g.AddWeightEdge(3, 0, netrics.INF); err == nil {
+		t.Fatal("infinite weight should be rejected")
+	}
+
+	if err := g.AddWeightEdge(3, 0, netrics.Distance(math.NaN())); err == nil {
+		t.Fatal("NaN weight should be rejected")
+	}
+
+	u := g.ToUnit()
+	pu := g.ToParallelUnit(4)
+
+	for _, p := range []netrics.Path{u.ShortestPath(0, 3), pu.ShortestPath(0, 3)} {
+		if math.Abs(float64(p.Distance())-0.875) > 1e-12 || len(p.Nodes()) != 4 {
+			t.Fatalf("invalid path: %v", p)
+		}
+	}
+
+	for _, p := range []netrics.Path{u.ShortestPath(3, 0), pu.ShortestPath(3, 0)} {
+		if p.Distance() != netrics.INF {
+			t.Fatalf("unreachable path should have INF distance: %v", p)
+		}
+	}
+}
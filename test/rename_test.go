@@ -0,0 +1,80 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestRenameNode(t *testing.T) {
+	g := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 3)
+
+	for _, name := range []string{"a", "b", "a"} {
+		g.AddNode(name)
+	}
+
+	snapshot := g.Snapshot()
+
+	if err := g.RenameNode(0, "c"); err != nil {
+		t.Fatal(err)
+	}
+
+	if nodes, err := g.FindNodesByName("a"); err != nil || len(nodes) != 1 || nodes[0].ID() != 2 {
+		t.Fatalf("stale name index: %v, %v", nodes, err)
+	}
+
+	if nodes, err := g.FindNodesByName("c"); err != nil || nodes[0].Name() != "c" {
+		t.Fatalf("renamed node not found: %v", err)
+	}
+
+	// The snapshot keeps the old name.
+	if node, _ := snapshot.FindNode(0); node.Name() != "a" {
+		t.Fatalf("snapshot changed: %q", node.Name())
+	}
+
+	if err := snapshot.RenameNode(0, "d"); !errors.Is(err, netrics.ErrReadOnly) {
+		t.Fatalf("expected ErrReadOnly, got %v", err)
+	}
+
+	if err := g.RenameNode(7, "d"); !errors.Is(err, netrics.ErrNotExistNode) {
+		t.Fatalf("expected ErrNotExistNode, got %v", err)
+	}
+}
+
+func TestUniqueNames(t *testing.T) {
+	g := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 2, netrics.UNIQUE_NAMES)
+
+	if !g.HasOption(netrics.UNIQUE_NAMES) {
+		t.Fatal("option not recorded")
+	}
+
+	g.AddNode("a")
+	g.AddNode("b")
+
+	var already *netrics.AlreadyNodeError
+
+	if _, err := g.AddNode("a"); !errors.As(err, &already) || already.Name != "a" || already.ID != 0 {
+		t.Fatalf("expected AlreadyNodeError for name a, got %v", err)
+	}
+
+	if err := g.RenameNode(1, "a"); !errors.Is(err, netrics.ErrAlreadyNode) {
+		t.Fatalf("expected ErrAlreadyNode, got %v", err)
+	}
+
+	if err := g.RenameNode(1, "b"); err != nil {
+		t.Fatalf("renaming a node to its own name should succeed: %v", err)
+	}
+
+	if err := g.RenameNode(1, "c"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := g.AddNode("b"); err != nil {
+		t.Fatalf("the old name should be free again: %v", err)
+	}
+
+	if g.NodeCount() != 3 {
+		t.Fatalf("expected 3 nodes, got %d", g.NodeCount())
+	}
+}
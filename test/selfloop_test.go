@@ -0,0 +1,94 @@
+package test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestSelfLoops(t *testing.T) {
+	plain := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 1)
+	plain.AddNode("a")
+
+	if err := plain.AddEdge(0, 0); !errors.Is(err, netrics.ErrSelfEdge) {
+		t.Fatalf("self-loop should be rejected without the option: %v", err)
+	}
+
+	g := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 4, netrics.ALLOW_SELF_LOOPS)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		g.AddNode(name)
+	}
+
+	// A triangle with a loop on node 0, and a pendant node 3 attached to 0.
+	g.AddEdge(0, 0)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(0, 3)
+
+	if g.EdgeCount() != 5 {
+		t.Fatalf("unexpected edge count: %d", g.EdgeCount())
+	}
+
+	if _, err := g.FindEdge(0, 0); err != nil {
+		t.Fatalf("loop not found: %v", err)
+	}
+
+	loops := 0
+
+	g.Edges()(func(e netrics.Edge) bool {
+		if e.From == e.To {
+			loops++
+		}
+
+		return true
+	})
+
+	if loops != 1 {
+		t.Fatalf("an undirected loop should be visited once, got %d", loops)
+	}
+
+	if degree, _ := g.OutDegree(0); degree != 5 {
+		t.Fatalf("a loop should add 2 to the undirected degree, got %d", degree)
+	}
+
+	u := g.ToUnit()
+
+	if got := u.DegreeCentrality()[0]; math.Abs(got-5.0/3) > 1e-9 {
+		t.Fatalf("unexpected degree centrality: %v", got)
+	}
+
+	// Node 0 has neighbors 1, 2 and 3 and one edge between them; the loop is ignored.
+	if local, _ := u.ClusteringCoefficient(); math.Abs(local[0]-1.0/3) > 1e-9 || local[1] != 1 {
+		t.Fatalf("unexpected clustering coefficients: %v", local)
+	}
+
+	if err := g.RemoveEdge(0, 0); err != nil {
+		t.Fatalf("loop not removed: %v", err)
+	}
+
+	if degree, _ := g.OutDegree(0); degree != 3 || g.EdgeCount() != 4 {
+		t.Fatalf("unexpected degree %d and edge count %d after removal", degree, g.EdgeCount())
+	}
+
+	// In a directed graph a loop adds 1 to both the in- and the out-degree.
+	d := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 2, netrics.ALLOW_SELF_LOOPS)
+	d.AddNode("a")
+	d.AddNode("b")
+	d.AddEdge(0, 0)
+	d.AddEdge(0, 1)
+
+	out, _ := d.OutDegree(0)
+	in, _ := d.InDegree(0)
+
+	if out != 2 || in != 1 {
+		t.Fatalf("unexpected directed degrees: out %d, in %d", out, in)
+	}
+
+	if err := d.RemoveNode(0); err != nil || d.EdgeCount() != 0 {
+		t.Fatalf("removing the node should remove its loop: %v, %d edges", err, d.EdgeCount())
+	}
+}
@@ -0,0 +1,155 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+// weekGraph builds a weighted graph over named nodes from a list of weighted edges between names.
+func weekGraph(t *testing.T, names []string, edges map[[2]string]netrics.Distance) netrics.Graph {
+	g := netrics.NewGraph(netrics.UNDIRECTED_WEIGHTED, len(names))
+	ids := map[string]netrics.NodeID{}
+
+	for _, name := range names {
+		node, _ := g.AddNode(name)
+		ids[name] = node.ID()
+	}
+
+	for e, d := range edges {
+		if err := g.AddWeightEdge(ids[e[0]], ids[e[1]], d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return g
+}
+
+func TestSetOperations(t *testing.T) {
+	// The nodes are added in a different order, so only the names line up.
+	first := weekGraph(t, []string{"a", "b", "c"}, map[[2]string]netrics.Distance{{"a", "b"}: 1, {"b", "c"}: 4})
+	second := weekGraph(t, []string{"c", "b", "d"}, map[[2]string]netrics.Distance{{"c", "b"}: 2, {"b", "d"}: 3})
+
+	union, translation, err := first.Union(second, nil, netrics.MERGE_MAX)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if union.NodeCount() != 4 || union.EdgeCount() != 3 {
+		t.Fatalf("unexpected union: %d nodes, %d edges", union.NodeCount(), union.EdgeCount())
+	}
+
+	if translation[0] != 2 || translation[1] != 1 || translation[2] != 3 {
+		t.Fatalf("unexpected translation: %v", translation)
+	}
+
+	if d, _ := union.FindEdge(1, 2); *d != 4 {
+		t.Fatalf("expected the larger weight 4, got %v", *d)
+	}
+
+	intersection, err := first.Intersection(second, nil, netrics.MERGE_SUM)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if intersection.NodeCount() != 2 || intersection.EdgeCount() != 1 {
+		t.Fatalf("unexpected intersection: %d nodes, %d edges", intersection.NodeCount(), intersection.EdgeCount())
+	}
+
+	if d, _ := intersection.FindEdge(2, 1); *d != 6 {
+		t.Fatalf("expected the summed weight 6, got %v", *d)
+	}
+
+	difference, err := first.Difference(second, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if difference.NodeCount() != 3 || difference.EdgeCount() != 1 {
+		t.Fatalf("unexpected difference: %d nodes, %d edges", difference.NodeCount(), difference.EdgeCount())
+	}
+
+	if _, err := difference.FindEdge(0, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// An explicit mapping overrides the names: "d" of the second graph stands for "a".
+	explicit, err := first.Intersection(second, netrics.IDMap{2: 0, 1: 1}, netrics.MERGE_MIN)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if explicit.EdgeCount() != 1 {
+		t.Fatalf("expected 1 common edge, got %d", explicit.EdgeCount())
+	}
+
+	if d, _ := explicit.FindEdge(0, 1); *d != 1 {
+		t.Fatalf("expected the smaller weight 1, got %v", *d)
+	}
+
+	disjoint, translation, err := first.DisjointUnion(second)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if disjoint.NodeCount() != 6 || disjoint.EdgeCount() != 4 || translation[0] != 3 {
+		t.Fatalf("unexpected disjoint union: %d nodes, %d edges, %v", disjoint.NodeCount(), disjoint.EdgeCount(), translation)
+	}
+
+	directed := netrics.NewGraph(netrics.DIRECTED_WEIGHTED, 0)
+
+	if _, _, err := first.Union(directed, nil, netrics.MERGE_MIN); !errors.Is(err, netrics.ErrTypeMismatch) {
+		t.Fatalf("expected ErrTypeMismatch, got %v", err)
+	}
+
+	// Graphs implemented outside the package, and nil graphs, are rejected instead of panicking.
+	foreign := struct{ netrics.Graph }{second}
+
+	if _, _, err := first.Union(foreign, nil, netrics.MERGE_MIN); !errors.Is(err, netrics.ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter, got %v", err)
+	}
+
+	if _, err := first.Difference(nil, nil); !errors.Is(err, netrics.ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter, got %v", err)
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	path := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 3)
+
+	for _, name := range []string{"a", "b", "c"} {
+		path.AddNode(name)
+	}
+
+	path.AddEdge(0, 1)
+	path.AddEdge(1, 2)
+
+	// The product of two paths of 3 nodes is a 3x3 grid.
+	grid, pairs, err := path.CartesianProduct(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if grid.NodeCount() != 9 || grid.EdgeCount() != 12 || len(pairs) != 9 {
+		t.Fatalf("unexpected product: %d nodes, %d edges", grid.NodeCount(), grid.EdgeCount())
+	}
+
+	if pairs[5] != (netrics.NodePair{First: 1, Second: 2}) {
+		t.Fatalf("unexpected pair %v", pairs[5])
+	}
+
+	if node, _ := grid.FindNode(5); node.Name() != "(b, c)" {
+		t.Fatalf("unexpected name %q", node.Name())
+	}
+
+	if degree, _ := grid.OutDegree(4); degree != 4 {
+		t.Fatalf("the centre of the grid should have 4 neighbours, got %d", degree)
+	}
+}
@@ -0,0 +1,84 @@
+package test
+
+import (
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestSnapshot(t *testing.T) {
+	g := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 4)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		g.AddNode(name)
+	}
+
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {1, 2}} {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := g.SetEdgeAttribute(0, 1, "capacity", 3); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot := g.Snapshot()
+
+	if !snapshot.IsReadOnly() || g.IsReadOnly() {
+		t.Fatal("only the snapshot should be read-only")
+	}
+
+	// The live graph keeps changing without affecting the snapshot.
+	g.AddEdge(2, 3)
+	g.RemoveEdge(0, 1)
+	g.RemoveNode(3)
+	g.AddNode("e")
+	g.SetNodeAttribute(1, "rank", 1)
+
+	if snapshot.NodeCount() != 4 || snapshot.EdgeCount() != 2 {
+		t.Fatalf("snapshot changed: %d nodes, %d edges", snapshot.NodeCount(), snapshot.EdgeCount())
+	}
+
+	if _, err := snapshot.FindEdge(1, 0); err != nil {
+		t.Fatal("removed edge should remain in the snapshot")
+	}
+
+	if value, err := snapshot.EdgeAttribute(1, 0, "capacity"); err != nil || value != 3 {
+		t.Fatal("edge attributes should remain in the snapshot")
+	}
+
+	if _, err := snapshot.NodeAttribute(1, "rank"); err == nil {
+		t.Fatal("later attributes should not appear in the snapshot")
+	}
+
+	if nodes, _ := snapshot.FindNodesByName("e"); len(nodes) != 0 {
+		t.Fatal("later nodes should not appear in the snapshot")
+	}
+
+	// Algorithms run on the frozen state.
+	if count := snapshot.ToUnit().ComponentCount(false); count != 2 {
+		t.Fatalf("invalid snapshot component count: %d", count)
+	}
+
+	if count := g.ToParallelUnit(2).ComponentCount(false); count != 3 {
+		t.Fatalf("invalid live component count: %d", count)
+	}
+
+	// Mutators of the snapshot fail.
+	if _, err := snapshot.AddNode("f"); err == nil {
+		t.Fatal("snapshot should reject AddNode")
+	}
+
+	if err := snapshot.AddEdge(0, 2); err == nil {
+		t.Fatal("snapshot should reject AddEdge")
+	}
+
+	if err := snapshot.SetNodeAttribute(0, "rank", 2); err == nil {
+		t.Fatal("snapshot should reject SetNodeAttribute")
+	}
+
+	if _, err := snapshot.Compact(); err == nil {
+		t.Fatal("snapshot should reject Compact")
+	}
+}
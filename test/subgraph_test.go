@@ -0,0 +1,92 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestSubgraph(t *testing.T) {
+	g := netrics.NewGraph(netrics.UNDIRECTED_WEIGHTED, 5)
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		g.AddNode(name)
+	}
+
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {1, 3}} {
+		if err := g.AddWeightEdge(e[0], e[1], netrics.Distance(e[0]+e[1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	g.SetEdgeAttribute(1, 3, "capacity", 7)
+
+	sub, mapping, err := g.Subgraph([]netrics.NodeID{3, 1, 2, 3})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sub.Type() != g.Type() || sub.NodeCount() != 3 || sub.EdgeCount() != 3 {
+		t.Fatalf("unexpected subgraph: %d nodes, %d edges", sub.NodeCount(), sub.EdgeCount())
+	}
+
+	for id, original := range mapping {
+		node, _ := sub.FindNode(id)
+		want, _ := g.FindNode(original)
+
+		if node.Name() != want.Name() {
+			t.Fatalf("node %d: name %q, want %q", id, node.Name(), want.Name())
+		}
+	}
+
+	if value, err := sub.EdgeAttribute(0, 2, "capacity"); err != nil || value != 7 {
+		t.Fatalf("edge attribute not kept: %v, %v", value, err)
+	}
+
+	// Metrics on the subgraph translate back to the original identifiers.
+	degree := netrics.TranslateKeys(mapping, sub.ToUnit().DegreeCentrality())
+
+	if len(degree) != 3 || degree[1] != 1 || degree[2] != 1 || degree[3] != 1 {
+		t.Fatalf("unexpected translated degree centrality: %v", degree)
+	}
+
+	if _, _, err := g.Subgraph([]netrics.NodeID{0, 9}); !errors.Is(err, netrics.ErrNotExistNode) {
+		t.Fatalf("expected ErrNotExistNode, got %v", err)
+	}
+}
+
+func TestEdgeSubgraph(t *testing.T) {
+	g := netrics.NewGraph(netrics.DIRECTED_UNWEIGHTED, 4)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		g.AddNode(name)
+	}
+
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {1, 2}, {2, 0}, {2, 3}} {
+		g.AddEdge(e[0], e[1])
+	}
+
+	sub, mapping, err := g.EdgeSubgraph([]netrics.EdgeKey{{From: 2, To: 3}, {From: 1, To: 2}})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sub.NodeCount() != 3 || sub.EdgeCount() != 2 {
+		t.Fatalf("unexpected subgraph: %d nodes, %d edges", sub.NodeCount(), sub.EdgeCount())
+	}
+
+	if mapping[0] != 1 || mapping[1] != 2 || mapping[2] != 3 {
+		t.Fatalf("unexpected mapping: %v", mapping)
+	}
+
+	if _, err := sub.FindEdge(1, 0); err == nil {
+		t.Fatal("edge direction was not kept")
+	}
+
+	if _, _, err := g.EdgeSubgraph([]netrics.EdgeKey{{From: 1, To: 0}}); !errors.Is(err, netrics.ErrNotExistEdge) {
+		t.Fatalf("expected ErrNotExistEdge, got %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+func TestTransforms(t *testing.T) {
+	g := netrics.NewGraph(netrics.DIRECTED_WEIGHTED, 3)
+
+	for _, name := range []string{"a", "b", "c"} {
+		g.AddNode(name)
+	}
+
+	g.AddWeightEdge(0, 1, 2)
+	g.AddWeightEdge(1, 0, 5)
+	g.AddWeightEdge(1, 2, 1)
+	g.SetEdgeAttribute(0, 1, "label", "x")
+
+	transposed := g.Transpose()
+
+	if _, err := transposed.FindEdge(2, 1); err != nil || transposed.EdgeCount() != 3 {
+		t.Fatalf("edge was not reversed: %v", err)
+	}
+
+	if node, _ := transposed.FindNode(2); node.Name() != "c" {
+		t.Fatalf("name not kept: %q", node.Name())
+	}
+
+	if value, _ := transposed.EdgeAttribute(1, 0, "label"); value != "x" {
+		t.Fatalf("attribute not kept: %v", value)
+	}
+
+	complement := g.Complement()
+
+	if complement.EdgeCount() != 3 {
+		t.Fatalf("expected 3 edges in the complement, got %d", complement.EdgeCount())
+	}
+
+	for _, e := range [][2]netrics.NodeID{{0, 2}, {2, 0}, {2, 1}} {
+		if _, err := complement.FindEdge(e[0], e[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for merge, want := range map[netrics.WeightMerge]netrics.Distance{netrics.MERGE_MIN: 2, netrics.MERGE_MAX: 5, netrics.MERGE_SUM: 7} {
+		undirected, err := g.ToUndirected(merge)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if undirected.Type() != netrics.UNDIRECTED_WEIGHTED || undirected.EdgeCount() != 2 {
+			t.Fatalf("%v: unexpected graph %v with %d edges", merge, undirected.Type(), undirected.EdgeCount())
+		}
+
+		if d, _ := undirected.FindEdge(1, 0); *d != want {
+			t.Fatalf("%v: distance %v, want %v", merge, *d, want)
+		}
+	}
+
+	undirected, _ := g.ToUndirected(netrics.MERGE_MIN)
+	directed := undirected.ToDirected()
+
+	if directed.Type() != netrics.DIRECTED_WEIGHTED || directed.EdgeCount() != 4 {
+		t.Fatalf("unexpected graph %v with %d edges", directed.Type(), directed.EdgeCount())
+	}
+
+	// Negative distances cannot become undirected edges unless their reverse offsets them.
+	n := netrics.NewGraph(netrics.DIRECTED_WEIGHTED, 2)
+	n.AddNode("a")
+	n.AddNode("b")
+	n.AddWeightEdge(0, 1, -1)
+
+	if _, err := n.ToUndirected(netrics.MERGE_MIN); !errors.Is(err, netrics.ErrInvalidEdge) {
+		t.Fatalf("a negative distance should be rejected: %v", err)
+	}
+
+	n.AddWeightEdge(1, 0, 3)
+
+	if offset, err := n.ToUndirected(netrics.MERGE_SUM); err != nil || offset.EdgeCount() != 1 {
+		t.Fatalf("the summed distance 2 should be accepted: %v, %v", offset, err)
+	}
+
+	// Sums that overflow are rejected.
+	n.RemoveEdge(1, 0)
+	n.AddWeightEdge(1, 0, math.MaxFloat64*0.75)
+	n.RemoveEdge(0, 1)
+	n.AddWeightEdge(0, 1, math.MaxFloat64*0.75)
+
+	if _, err := n.ToUndirected(netrics.MERGE_SUM); !errors.Is(err, netrics.ErrInvalidEdge) {
+		t.Fatalf("an overflowing sum should be rejected: %v", err)
+	}
+}
+
+func TestLineGraph(t *testing.T) {
+	g := netrics.NewGraph(netrics.UNDIRECTED_UNWEIGHTED, 4)
+
+	for _, name := range []string{"a", "b", "c", "d"} {
+		g.AddNode(name)
+	}
+
+	// A star around node 0 plus one edge between two of its leaves.
+	for _, e := range [][2]netrics.NodeID{{0, 1}, {0, 2}, {0, 3}, {2, 3}} {
+		g.AddEdge(e[0], e[1])
+	}
+
+	line, keys := g.LineGraph()
+
+	if line.NodeCount() != 4 || len(keys) != 4 {
+		t.Fatalf("expected 4 line graph nodes, got %d", line.NodeCount())
+	}
+
+	if keys[3] != (netrics.EdgeKey{From: 2, To: 3}) {
+		t.Fatalf("unexpected edge order: %v", keys)
+	}
+
+	if node, _ := line.FindNode(1); node.Name() != "a-c" {
+		t.Fatalf("unexpected name %q", node.Name())
+	}
+
+	// The three star edges form a triangle, and edge {2, 3} touches {0, 2} and {0, 3}.
+	if line.EdgeCount() != 5 {
+		t.Fatalf("expected 5 line graph edges, got %d", line.EdgeCount())
+	}
+
+	if _, err := line.FindEdge(0, 3); err == nil {
+		t.Fatal("edges {0, 1} and {2, 3} share no endpoint")
+	}
+}
@@ -0,0 +1,133 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	netrics "github.com/elecbug/go-netrics"
+)
+
+type server struct {
+	Host string
+	Core int
+}
+
+type link struct {
+	Latency float64
+	Label   string
+}
+
+func TestTypedGraph(t *testing.T) {
+	g := netrics.NewTypedGraph[server, link](netrics.DIRECTED_WEIGHTED, 3,
+		func(l link) netrics.Distance { return netrics.Distance(l.Latency) })
+
+	for i, host := range []string{"a", "b", "c"} {
+		if _, err := g.AddNodeWith(host, server{Host: host + ".local", Core: i + 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	g.AddEdgeWith(0, 1, link{Latency: 2, Label: "fast"})
+	g.AddEdgeWith(1, 2, link{Latency: 3, Label: "slow"})
+	g.AddEdgeWith(0, 2, link{Latency: 9, Label: "backup"})
+
+	if s, err := g.NodePayload(1); err != nil || s.Host != "b.local" || s.Core != 2 {
+		t.Fatalf("unexpected node payload: %v, %v", s, err)
+	}
+
+	if d, _ := g.FindEdge(0, 1); *d != 2 {
+		t.Fatalf("distance should come from the payload, got %v", *d)
+	}
+
+	// Units compute on the distances taken from the payloads.
+	if p := g.ToUnit().ShortestPath(0, 2); p.Distance() != 5 {
+		t.Fatalf("unexpected shortest path: %v", p)
+	}
+
+	// Replacing an edge payload updates its distance, and read-only snapshots keep the old payloads.
+	snapshot, err := netrics.Typed[server, link](g.Snapshot(), nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := g.SetEdgePayload(0, 2, link{Latency: 1, Label: "upgraded"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if p := g.ToUnit().ShortestPath(0, 2); p.Distance() != 1 {
+		t.Fatalf("distance not updated: %v", p)
+	}
+
+	if l, _ := snapshot.EdgePayload(0, 2); l.Label != "backup" {
+		t.Fatalf("snapshot payload changed: %v", l)
+	}
+
+	if err := snapshot.SetNodePayload(0, server{}); !errors.Is(err, netrics.ErrReadOnly) {
+		t.Fatalf("snapshot should be read-only: %v", err)
+	}
+
+	// Payloads survive derived graphs.
+	sub, _, err := g.Subgraph([]netrics.NodeID{1, 2})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	typed, err := netrics.Typed[server, link](sub, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := netrics.Typed[server, link](nil, nil); !errors.Is(err, netrics.ErrInvalidParameter) {
+		t.Fatalf("a nil graph should be rejected: %v", err)
+	}
+
+	if s, _ := typed.NodePayload(0); s.Host != "b.local" {
+		t.Fatalf("subgraph node payload lost: %v", s)
+	}
+
+	if l, _ := typed.EdgePayload(0, 1); l.Label != "slow" {
+		t.Fatalf("subgraph edge payload lost: %v", l)
+	}
+
+	// A plain node has no payload, and a payload weighing less than 0 is rejected in undirected graphs.
+	plain, _ := g.AddNode("d")
+
+	if s, err := g.NodePayload(plain.ID()); err != nil || s != (server{}) || plain.Payload() != nil {
+		t.Fatalf("unexpected payload of a plain node: %v, %v", s, err)
+	}
+
+	u := netrics.NewTypedGraph[string, float64](netrics.UNDIRECTED_WEIGHTED, 2,
+		func(w float64) netrics.Distance { return netrics.Distance(w) })
+	u.AddNodeWith("x", "first")
+	u.AddNodeWith("y", "second")
+
+	if _, err := u.AddEdgeWith(0, 1, -1); !errors.Is(err, netrics.ErrInvalidEdge) {
+		t.Fatalf("negative weight should be rejected: %v", err)
+	}
+
+	if _, err := u.EdgePayload(0, 1); !errors.Is(err, netrics.ErrNotExistEdge) {
+		t.Fatalf("missing edge should fail: %v", err)
+	}
+
+	u.AddEdgeWith(0, 1, 2)
+
+	// Reading the payloads as other types fails instead of returning zero values.
+	wrong, err := netrics.Typed[int, int](u, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var mismatch *netrics.PayloadTypeError
+
+	if _, err := wrong.NodePayload(0); !errors.As(err, &mismatch) || mismatch.Expected != "int" || mismatch.Actual != "string" {
+		t.Fatalf("unexpected error for a node payload of another type: %v", err)
+	}
+
+	if _, err := wrong.EdgePayload(1, 0); !errors.Is(err, netrics.ErrPayloadType) {
+		t.Fatalf("unexpected error for an edge payload of another type: %v", err)
+	}
+}